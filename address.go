@@ -0,0 +1,65 @@
+package outlook
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// NewRecipient returns a Recipient with the given display name, which may be empty, and address.
+func NewRecipient(name, address string) *Recipient {
+	return &Recipient{EmailAddress: &EmailAddress{Name: name, Address: address}}
+}
+
+// ParseRecipient parses a single RFC 5322 address, either bare or in "Display Name <user@example.com>" form, into a Recipient.
+func ParseRecipient(address string) (*Recipient, error) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("outlook: invalid address %q: %w", address, err)
+	}
+	return NewRecipient(parsed.Name, parsed.Address), nil
+}
+
+// ParseRecipientList parses a comma separated list of RFC 5322 addresses into Recipients. An empty list parses to no recipients.
+func ParseRecipientList(list string) ([]*Recipient, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+	parsed, err := mail.ParseAddressList(list)
+	if err != nil {
+		return nil, fmt.Errorf("outlook: invalid address list %q: %w", list, err)
+	}
+	recipients := make([]*Recipient, len(parsed))
+	for i, address := range parsed {
+		recipients[i] = NewRecipient(address.Name, address.Address)
+	}
+	return recipients, nil
+}
+
+// String formats the address as an RFC 5322 address, e.g. "Display Name <user@example.com>", encoding non ascii names.
+func (ea *EmailAddress) String() string {
+	if ea == nil {
+		return ""
+	}
+	return (&mail.Address{Name: ea.Name, Address: ea.Address}).String()
+}
+
+// String formats the recipient's address as an RFC 5322 address, see EmailAddress.String.
+func (r *Recipient) String() string {
+	if r == nil {
+		return ""
+	}
+	return r.EmailAddress.String()
+}
+
+// FormatRecipients formats recipients as a comma separated RFC 5322 address list, skipping those without an address.
+func FormatRecipients(recipients []*Recipient) string {
+	formatted := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		if recipient == nil || recipient.EmailAddress == nil {
+			continue
+		}
+		formatted = append(formatted, recipient.String())
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,115 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ProxyAddressTypeSMTP the type of a proxy address mail can be sent from and received at.
+const ProxyAddressTypeSMTP = "smtp"
+
+// ProxyAddress one of the addresses a mailbox receives mail at, as listed in the user's proxyAddresses.
+type ProxyAddress struct {
+	// Type the address's type, lower cased, e.g. ProxyAddressTypeSMTP, "sip", or "x500".
+	Type string
+	// Address the address without its type prefix.
+	Address string
+	// Primary whether the address is the mailbox's primary address of its type, marked by an upper case type prefix, e.g. "SMTP:".
+	Primary bool
+}
+
+// ParseProxyAddress parses an entry of a user's proxyAddresses, e.g. "SMTP:user@example.com" or "smtp:alias@example.com".
+// Entries without a type prefix are taken to be smtp addresses.
+func ParseProxyAddress(proxyAddress string) *ProxyAddress {
+	prefix, address, ok := strings.Cut(proxyAddress, ":")
+	if !ok || strings.Contains(prefix, "@") {
+		return &ProxyAddress{Type: ProxyAddressTypeSMTP, Address: proxyAddress}
+	}
+	return &ProxyAddress{
+		Type:    strings.ToLower(prefix),
+		Address: address,
+		Primary: prefix == strings.ToUpper(prefix),
+	}
+}
+
+// String formats the proxy address as it is listed in proxyAddresses.
+func (pa *ProxyAddress) String() string {
+	prefix := pa.Type
+	if pa.Primary {
+		prefix = strings.ToUpper(prefix)
+	}
+	return prefix + ":" + pa.Address
+}
+
+// MailboxAliases the smtp addresses a mailbox can send from, primary first.
+type MailboxAliases []*ProxyAddress
+
+// Primary returns the mailbox's primary smtp address, or nil if the list has none.
+func (ma MailboxAliases) Primary() *ProxyAddress {
+	for _, alias := range ma {
+		if alias.Primary {
+			return alias
+		}
+	}
+	return nil
+}
+
+// Contains reports whether address, compared case insensitively, is one of the aliases.
+func (ma MailboxAliases) Contains(address string) bool {
+	for _, alias := range ma {
+		if strings.EqualFold(alias.Address, address) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error wrapping ErrUnknownAlias unless the recipient's address is one of the aliases.
+func (ma MailboxAliases) Validate(from *Recipient) error {
+	if from == nil || from.EmailAddress == nil {
+		return fmt.Errorf("%w: no from address", ErrUnknownAlias)
+	}
+	if !ma.Contains(from.EmailAddress.Address) {
+		return fmt.Errorf("%w: %s", ErrUnknownAlias, from.EmailAddress.Address)
+	}
+	return nil
+}
+
+// AliasListCall struct allowing for fluent style configuration of calls listing the aliases of a session's mailbox.
+type AliasListCall struct {
+	session *Session
+}
+
+// Aliases returns an instance of an AliasListCall listing the smtp addresses the session's mailbox can send from.
+// Sending from an alias other than the primary address requires the tenant to have enabled SendFromAliasEnabled.
+func (session *Session) Aliases() *AliasListCall {
+	return &AliasListCall{session: session}
+}
+
+// Do executes the http get request to microsoft's graph api to read the mailbox's proxyAddresses, returning its smtp addresses,
+// primary first. Mailboxes without proxy addresses, such as those outside of exchange online, list their mail address alone.
+func (alc *AliasListCall) Do(ctx context.Context, opts ...RequestOption) (MailboxAliases, error) {
+	var user User
+	params := map[string]interface{}{"$select": "mail,proxyAddresses"}
+	if _, err := alc.session.Get(ctx, "", params, &user, opts...); err != nil {
+		return nil, err
+	}
+
+	var aliases MailboxAliases
+	for _, proxyAddress := range user.ProxyAddresses {
+		parsed := ParseProxyAddress(proxyAddress)
+		if parsed.Type != ProxyAddressTypeSMTP {
+			continue
+		}
+		if parsed.Primary {
+			aliases = append(MailboxAliases{parsed}, aliases...)
+		} else {
+			aliases = append(aliases, parsed)
+		}
+	}
+	if len(aliases) == 0 && user.Mail != "" {
+		aliases = MailboxAliases{{Type: ProxyAddressTypeSMTP, Address: user.Mail, Primary: true}}
+	}
+	return aliases, nil
+}
@@ -0,0 +1,224 @@
+package outlook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// AuthOpt functions to configure the authentication helpers.
+type AuthOpt func(*authOptions)
+
+type authOptions struct {
+	authority Authority
+	appScope  string
+	cache     TokenCache
+	cacheKey  string
+	rotation  TokenRotationFunc
+}
+
+func newAuthOptions(opts []AuthOpt) *authOptions {
+	options := &authOptions{
+		authority: DefaultAuthority,
+		appScope:  DefaultAppScope,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// tenantAuthority returns the authority for tenant, on the configured authority host unless tenant is itself a full authority url.
+func (o *authOptions) tenantAuthority(tenant string) Authority {
+	authority := NewAuthority(tenant)
+	if !strings.Contains(tenant, "://") {
+		authority.Host = o.authority.Host
+	}
+	return authority
+}
+
+// TenantTokenURL returns the token endpoint of the microsoft identity platform for the given tenant, interpreted as by NewAuthority.
+func TenantTokenURL(tenant string) string {
+	return NewAuthority(tenant).TokenURL()
+}
+
+// TenantEndpoint returns the oauth2 endpoints of the microsoft identity platform for the given tenant, interpreted as by NewAuthority.
+func TenantEndpoint(tenant string) oauth2.Endpoint {
+	return NewAuthority(tenant).Endpoint()
+}
+
+// AssertionProvider returns a signed assertion proving the identity of the application, such as an OIDC token issued by
+// GitHub Actions or a SPIFFE JWT-SVID, which microsoft has been configured to trust through a federated identity credential.
+type AssertionProvider func(ctx context.Context) (string, error)
+
+// AssertionFromFile returns an AssertionProvider which reads the assertion from the file at path on every call, as projected service account tokens are rotated in place.
+func AssertionFromFile(path string) AssertionProvider {
+	return func(context.Context) (string, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+}
+
+// NewClientAssertionTokenSource returns a TokenSource which acquires app-only tokens for the given tenant using the client credentials grant,
+// authenticating with an assertion from provider rather than a client secret. This enables workload identity federation.
+func NewClientAssertionTokenSource(ctx context.Context, tenant, clientID string, provider AssertionProvider, opts ...AuthOpt) oauth2.TokenSource {
+	options := newAuthOptions(opts)
+	return NewReuseTokenSource(nil, &assertionTokenSource{
+		ctx:       ctx,
+		tokenURL:  options.tenantAuthority(tenant).TokenURL(),
+		clientID:  clientID,
+		scope:     options.appScope,
+		assertion: provider,
+	})
+}
+
+// NewClientCredentialsTokenSource returns a TokenSource which acquires app-only tokens for the given tenant using the client credentials grant.
+// Sessions built on it have no signed in user, so must be created with Client.NewSessionForUser or Session.ForUser.
+func NewClientCredentialsTokenSource(ctx context.Context, tenant, clientID, clientSecret string, opts ...AuthOpt) oauth2.TokenSource {
+	options := newAuthOptions(opts)
+	return NewReuseTokenSource(nil, &clientSecretTokenSource{
+		ctx:          ctx,
+		tokenURL:     options.tenantAuthority(tenant).TokenURL(),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scope:        options.appScope,
+	})
+}
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// tokenResponse the standard OAuth 2.0 token endpoint response, extended with the expires_on field some azure endpoints return instead of expires_in.
+type tokenResponse struct {
+	AccessToken  string      `json:"access_token"`
+	RefreshToken string      `json:"refresh_token"`
+	TokenType    string      `json:"token_type"`
+	ExpiresIn    json.Number `json:"expires_in"`
+	ExpiresOn    json.Number `json:"expires_on"`
+	IDToken      string      `json:"id_token"`
+}
+
+func (tr *tokenResponse) token() (*oauth2.Token, error) {
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("token response contained no access token")
+	}
+	token := &oauth2.Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		TokenType:    tr.TokenType,
+	}
+	if expiresIn, err := tr.ExpiresIn.Int64(); err == nil && expiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	} else if expiresOn, err := tr.ExpiresOn.Int64(); err == nil && expiresOn > 0 {
+		token.Expiry = time.Unix(expiresOn, 0)
+	}
+	if tr.IDToken != "" {
+		token = token.WithExtra(map[string]interface{}{"id_token": tr.IDToken})
+	}
+	return token, nil
+}
+
+// retrieveToken sends req to a token endpoint and parses the resulting token, returning an *oauth2.RetrieveError when the endpoint rejects the request.
+func retrieveToken(ctx context.Context, req *http.Request) (*oauth2.Token, error) {
+	res, err := contextHTTPClient(ctx).Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, &oauth2.RetrieveError{Response: res, Body: body}
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+	return tr.token()
+}
+
+// postTokenForm posts form to the token endpoint at tokenURL and parses the resulting token.
+func postTokenForm(ctx context.Context, tokenURL string, form url.Values) (*oauth2.Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return retrieveToken(ctx, req)
+}
+
+// contextHTTPClient returns the http client registered on ctx under oauth2.HTTPClient, falling back to DefaultClient.
+func contextHTTPClient(ctx context.Context) *http.Client {
+	if client, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && client != nil {
+		return client
+	}
+	return DefaultClient
+}
+
+// assertionTokenSource acquires tokens with the client credentials grant, authenticating with a client assertion rather than a secret.
+type assertionTokenSource struct {
+	ctx       context.Context
+	tokenURL  string
+	clientID  string
+	scope     string
+	assertion AssertionProvider
+}
+
+func (ats *assertionTokenSource) Token() (*oauth2.Token, error) {
+	return ats.TokenContext(ats.ctx)
+}
+
+func (ats *assertionTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	ctx = withTokenHTTPClient(ctx, ats.ctx)
+	assertion, err := ats.assertion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client assertion: %w", err)
+	}
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {ats.clientID},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+		"scope":                 {ats.scope},
+	}
+	return postTokenForm(ctx, ats.tokenURL, form)
+}
+
+// clientSecretTokenSource acquires tokens with the client credentials grant, authenticating with a client secret.
+type clientSecretTokenSource struct {
+	ctx          context.Context
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scope        string
+}
+
+func (csts *clientSecretTokenSource) Token() (*oauth2.Token, error) {
+	return csts.TokenContext(csts.ctx)
+}
+
+func (csts *clientSecretTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {csts.clientID},
+		"client_secret": {csts.clientSecret},
+		"scope":         {csts.scope},
+	}
+	return postTokenForm(withTokenHTTPClient(ctx, csts.ctx), csts.tokenURL, form)
+}
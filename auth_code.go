@@ -0,0 +1,169 @@
+package outlook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"golang.org/x/oauth2"
+)
+
+// AuthCodeFlow runs the authorization code grant with PKCE, producing a refreshable TokenSource for a signed in user.
+type AuthCodeFlow struct {
+	config   *oauth2.Config
+	options  *authOptions
+	verifier string
+	state    string
+}
+
+// NewAuthCodeFlow returns a new instance of an AuthCodeFlow for the given application and redirect url.
+// The flow signs users in against DefaultAuthority, whose endpoints are DefaultOAuthAuthorizeURL and DefaultOAuthTokenURL, unless another is set with SetAuthAuthority.
+// scopes defaults to DefaultScopes when empty.
+func NewAuthCodeFlow(clientID, redirectURL string, scopes []string, opts ...AuthOpt) (*AuthCodeFlow, error) {
+	if len(scopes) == 0 {
+		scopes = DefaultScopes.Strings()
+	}
+
+	options := newAuthOptions(opts)
+
+	state := make([]byte, 16)
+	if _, err := rand.Read(state); err != nil {
+		return nil, err
+	}
+
+	return &AuthCodeFlow{
+		config: &oauth2.Config{
+			ClientID:    clientID,
+			RedirectURL: redirectURL,
+			Scopes:      scopes,
+			Endpoint:    options.authority.Endpoint(),
+		},
+		options:  options,
+		verifier: oauth2.GenerateVerifier(),
+		state:    base64.RawURLEncoding.EncodeToString(state),
+	}, nil
+}
+
+// ClientSecret sets the secret used when exchanging the code, for confidential web applications. Public clients need not set it.
+func (acf *AuthCodeFlow) ClientSecret(secret string) *AuthCodeFlow {
+	acf.config.ClientSecret = secret
+	return acf
+}
+
+// AuthCodeURL returns the url the user must visit to sign in, carrying the flow's state and PKCE challenge.
+func (acf *AuthCodeFlow) AuthCodeURL(opts ...oauth2.AuthCodeOption) string {
+	opts = append(opts, oauth2.S256ChallengeOption(acf.verifier))
+	return acf.config.AuthCodeURL(acf.state, opts...)
+}
+
+// Restore returns a TokenSource built from the configured token cache, or nil when no usable token is cached and the user must sign in.
+func (acf *AuthCodeFlow) Restore(ctx context.Context) (oauth2.TokenSource, error) {
+	return acf.options.restore(ctx, acf.config)
+}
+
+// Exchange verifies state and exchanges the code received on the redirect for a token, returning a TokenSource which refreshes it automatically.
+// Use this when the code is received out-of-band, e.g. by a web handler or pasted in by the user.
+func (acf *AuthCodeFlow) Exchange(ctx context.Context, code, state string) (oauth2.TokenSource, error) {
+	if state != acf.state {
+		return nil, fmt.Errorf("authorization response state mismatch")
+	}
+	token, err := acf.config.Exchange(ctx, code, oauth2.VerifierOption(acf.verifier))
+	if err != nil {
+		return nil, err
+	}
+	return acf.options.wrap(ctx, newRefreshTokenSource(ctx, acf.config, token), nil), nil
+}
+
+// ListenAndExchange serves the redirect url on the loopback interface, hands the sign in url to open (e.g. to launch a browser),
+// waits for the redirect and exchanges its code. The redirect url must be an http://localhost or http://127.0.0.1 url; when it
+// has no port, as is usual for loopback redirects, a free port is bound and the redirect url sent to the identity platform
+// carries it, which microsoft accepts for any port on a loopback redirect registered without one.
+// A cached token is used instead of signing in again whenever possible.
+func (acf *AuthCodeFlow) ListenAndExchange(ctx context.Context, open func(authURL string) error) (oauth2.TokenSource, error) {
+	if restored, err := acf.Restore(ctx); err != nil || restored != nil {
+		return restored, err
+	}
+
+	redirect, err := url.Parse(acf.config.RedirectURL)
+	if err != nil {
+		return nil, err
+	}
+	if redirect.Scheme != "http" || (redirect.Hostname() != "localhost" && redirect.Hostname() != "127.0.0.1") {
+		return nil, fmt.Errorf("redirect url %q is not a loopback http url", acf.config.RedirectURL)
+	}
+
+	port := redirect.Port()
+	if port == "" {
+		port = "0"
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(redirect.Hostname(), port))
+	if err != nil {
+		return nil, err
+	}
+	if port == "0" {
+		// The code must be redeemed with the redirect url it was issued for, so the bound port is used for both, for this sign in only.
+		bound := *redirect
+		bound.Host = net.JoinHostPort(redirect.Hostname(), strconv.Itoa(listener.Addr().(*net.TCPAddr).Port))
+		registered := acf.config.RedirectURL
+		acf.config.RedirectURL = bound.String()
+		defer func() { acf.config.RedirectURL = registered }()
+	}
+
+	type callback struct {
+		code  string
+		state string
+		err   error
+	}
+	callbacks := make(chan callback, 1)
+
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		cb := callback{code: q.Get("code"), state: q.Get("state")}
+		if errCode := q.Get("error"); errCode != "" {
+			cb.err = fmt.Errorf("authorization failed: %s: %s", errCode, q.Get("error_description"))
+			http.Error(w, "Sign in failed, you may close this window.", http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Sign in complete, you may close this window.")
+		}
+		select {
+		case callbacks <- cb:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			select {
+			case callbacks <- callback{err: err}:
+			default:
+			}
+		}
+	}()
+	defer server.Close()
+
+	if err := open(acf.AuthCodeURL()); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case cb := <-callbacks:
+		if cb.err != nil {
+			return nil, cb.err
+		}
+		return acf.Exchange(ctx, cb.code, cb.state)
+	}
+}
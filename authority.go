@@ -0,0 +1,100 @@
+package outlook
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// Well known tenants which restrict sign in to a class of account rather than a single directory.
+const (
+	// TenantCommon allows both work or school accounts and personal microsoft accounts to sign in
+	TenantCommon = "common"
+	// TenantOrganizations allows only work or school accounts to sign in
+	TenantOrganizations = "organizations"
+	// TenantConsumers allows only personal microsoft accounts to sign in
+	TenantConsumers = "consumers"
+)
+
+// Authority identifies the microsoft identity platform instance and tenant tokens are requested from.
+// Single tenant app registrations reject the common tenant and must use their own tenant id or domain.
+type Authority struct {
+	Host   string
+	Tenant string
+	// GraphHost the graph api the authority's tokens are for, as set by Cloud.Authority. When empty it is inferred from Host,
+	// see GraphResource.
+	GraphHost string
+}
+
+// DefaultAuthority the multi-tenant authority used when none is configured.
+var DefaultAuthority = Authority{Host: DefaultAuthorityHost, Tenant: TenantCommon}
+
+// NewAuthority returns the Authority for tenant, which may be a tenant id, a domain, one of the well known tenants,
+// or a full authority url such as https://login.microsoftonline.us/contoso.onmicrosoft.com.
+func NewAuthority(tenant string) Authority {
+	if strings.HasPrefix(tenant, "https://") || strings.HasPrefix(tenant, "http://") {
+		trimmed := strings.TrimSuffix(tenant, "/")
+		if i := strings.LastIndex(trimmed, "/"); i > len("https://") {
+			return Authority{Host: trimmed[:i], Tenant: trimmed[i+1:]}
+		}
+		return Authority{Host: trimmed, Tenant: TenantCommon}
+	}
+	if tenant == "" {
+		tenant = TenantCommon
+	}
+	return Authority{Host: DefaultAuthorityHost, Tenant: tenant}
+}
+
+// String returns the authority url.
+func (a Authority) String() string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(a.Host, "/"), a.Tenant)
+}
+
+// GraphResource returns the graph api the authority's tokens are for: its GraphHost, or else the graph host of the cloud whose
+// identity platform is at Host, falling back to DefaultGraphResource. The US Government clouds share an identity platform, so
+// DoD applications must set GraphHost, e.g. with CloudUSGovDoD.Authority, rather than rely on the inferred GCC High host.
+func (a Authority) GraphResource() string {
+	if a.GraphHost != "" {
+		return strings.TrimSuffix(a.GraphHost, "/")
+	}
+	host := strings.TrimSuffix(a.Host, "/")
+	for _, cloud := range []Cloud{CloudGlobal, CloudUSGovHigh, CloudChina} {
+		if strings.EqualFold(host, cloud.AuthorityHost) {
+			return cloud.GraphHost
+		}
+	}
+	return DefaultGraphResource
+}
+
+// TokenURL returns the authority's token endpoint.
+func (a Authority) TokenURL() string {
+	return a.String() + "/oauth2/v2.0/token"
+}
+
+// AuthorizeURL returns the authority's authorization endpoint.
+func (a Authority) AuthorizeURL() string {
+	return a.String() + "/oauth2/v2.0/authorize"
+}
+
+// DeviceAuthURL returns the authority's device authorization endpoint.
+func (a Authority) DeviceAuthURL() string {
+	return a.String() + "/oauth2/v2.0/devicecode"
+}
+
+// Endpoint returns the authority's oauth2 endpoints.
+func (a Authority) Endpoint() oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:       a.AuthorizeURL(),
+		TokenURL:      a.TokenURL(),
+		DeviceAuthURL: a.DeviceAuthURL(),
+		AuthStyle:     oauth2.AuthStyleInParams,
+	}
+}
+
+// SetAuthAuthority returns an AuthOpt which signs users in against the given authority instead of DefaultAuthority.
+func SetAuthAuthority(authority Authority) AuthOpt {
+	return func(o *authOptions) {
+		o.authority = authority
+	}
+}
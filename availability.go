@@ -0,0 +1,97 @@
+package outlook
+
+import (
+	"fmt"
+	"time"
+)
+
+// FreeSlot a window of time in which everyone considered is available.
+type FreeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the free slot.
+func (fs FreeSlot) Duration() time.Duration {
+	return fs.End.Sub(fs.Start)
+}
+
+// MergeAvailabilityViews combines availabilityView strings of equal length into one, keeping the least available status for each interval.
+func MergeAvailabilityViews(views ...string) (string, error) {
+	if len(views) == 0 {
+		return "", nil
+	}
+
+	merged := []byte(views[0])
+	for _, view := range views[1:] {
+		if len(view) != len(merged) {
+			return "", fmt.Errorf("availability views differ in length: %d and %d", len(merged), len(view))
+		}
+		for i := range merged {
+			if availabilityRank(view[i]) > availabilityRank(merged[i]) {
+				merged[i] = view[i]
+			}
+		}
+	}
+	return string(merged), nil
+}
+
+// FreeSlots returns the windows of at least minDuration in which view is free.
+// start is the beginning of the first interval and interval is the length of each character in the view.
+func FreeSlots(view string, start time.Time, interval, minDuration time.Duration) []FreeSlot {
+	var slots []FreeSlot
+	runStart := -1
+	for i := 0; i <= len(view); i++ {
+		free := i < len(view) && view[i] == AvailabilityFree
+		if free && runStart < 0 {
+			runStart = i
+		}
+		if !free && runStart >= 0 {
+			slot := FreeSlot{
+				Start: start.Add(time.Duration(runStart) * interval),
+				End:   start.Add(time.Duration(i) * interval),
+			}
+			if slot.Duration() >= minDuration {
+				slots = append(slots, slot)
+			}
+			runStart = -1
+		}
+	}
+	return slots
+}
+
+// CommonFreeSlots intersects the availability of every schedule and returns the windows of at least minDuration in which all of them are free.
+// start and interval must match the values the schedules were requested with.
+func CommonFreeSlots(schedules []*ScheduleInformation, start time.Time, interval, minDuration time.Duration) ([]FreeSlot, error) {
+	views := make([]string, 0, len(schedules))
+	for _, schedule := range schedules {
+		if schedule.Error != nil {
+			return nil, fmt.Errorf("schedule %s unavailable: %s", schedule.ScheduleID, schedule.Error.Message)
+		}
+		views = append(views, schedule.AvailabilityView)
+	}
+
+	merged, err := MergeAvailabilityViews(views...)
+	if err != nil {
+		return nil, err
+	}
+	return FreeSlots(merged, start, interval, minDuration), nil
+}
+
+func availabilityRank(status byte) int {
+	switch status {
+	case AvailabilityFree:
+		return 0
+	case AvailabilityWorkingElsewhere:
+		return 1
+	case AvailabilityTentative:
+		return 2
+	case AvailabilityBusy:
+		return 3
+	case AvailabilityOOF:
+		return 4
+	default:
+		// Unknown statuses are treated as unavailable.
+		return 5
+	}
+}
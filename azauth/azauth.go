@@ -0,0 +1,48 @@
+// Package azauth bridges Azure SDK credentials to the oauth2.TokenSource expected by outlook.SetClientTokenSource,
+// so applications already using the azidentity credential chain can reuse it for graph calls.
+package azauth
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/ntauth/go-outlook"
+	"golang.org/x/oauth2"
+)
+
+type credentialTokenSource struct {
+	ctx        context.Context
+	credential azcore.TokenCredential
+	scopes     []string
+}
+
+// NewTokenSource returns a TokenSource which acquires tokens from credential, e.g. an azidentity.DefaultAzureCredential.
+// scopes defaults to outlook.DefaultAppScope when empty.
+func NewTokenSource(ctx context.Context, credential azcore.TokenCredential, scopes ...string) oauth2.TokenSource {
+	if len(scopes) == 0 {
+		scopes = []string{outlook.DefaultAppScope}
+	}
+	return outlook.NewReuseTokenSource(nil, &credentialTokenSource{
+		ctx:        ctx,
+		credential: credential,
+		scopes:     scopes,
+	})
+}
+
+func (cts *credentialTokenSource) Token() (*oauth2.Token, error) {
+	return cts.TokenContext(cts.ctx)
+}
+
+// TokenContext acquires a token from the credential under ctx, see outlook.ContextTokenSource.
+func (cts *credentialTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	accessToken, err := cts.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: cts.scopes})
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{
+		AccessToken: accessToken.Token,
+		TokenType:   "Bearer",
+		Expiry:      accessToken.ExpiresOn,
+	}, nil
+}
@@ -0,0 +1,227 @@
+package outlook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBulkSenderWorkers the number of messages a BulkSender sends concurrently by default
+	DefaultBulkSenderWorkers = 4
+	// DefaultBulkSenderMaxAttempts the number of times a BulkSender tries a message before giving up by default
+	DefaultBulkSenderMaxAttempts = 4
+	// DefaultBulkSenderBackoff the delay before a BulkSender's first retry, doubling on every further retry
+	DefaultBulkSenderBackoff = time.Second
+)
+
+// BulkMessage a message to send through a BulkSender from the mailbox of the given session.
+type BulkMessage struct {
+	// ID an identifier of the caller's choosing, e.g. a notification id, reported back on the message's BulkResult.
+	ID      string
+	Session *Session
+	Message *Message
+}
+
+// BulkResult the outcome of sending a single BulkMessage.
+type BulkResult struct {
+	ID       string
+	Message  *Message
+	Attempts int
+	// Err nil if the message was sent, otherwise the error of the last attempt.
+	Err error
+	// Ambiguous whether an attempt failed in a way which leaves it unknown if graph accepted the message, as for server errors
+	// other than a 503 asking to be retried later, and timeouts. The message may have reached its recipients, so resending it
+	// risks a duplicate; when set on a message sent by a later attempt, see SetBulkSenderRetryAmbiguous, it may have been sent twice.
+	Ambiguous bool
+}
+
+// BulkSenderOpt functions to configure options on a BulkSender.
+type BulkSenderOpt func(*BulkSender)
+
+// SetBulkSenderWorkers returns a BulkSenderOpt which sets how many messages are sent concurrently.
+// Requests to a single mailbox are additionally bounded by the client's mailbox concurrency.
+func SetBulkSenderWorkers(workers int) BulkSenderOpt {
+	return func(bs *BulkSender) {
+		bs.workers = workers
+	}
+}
+
+// SetBulkSenderMaxAttempts returns a BulkSenderOpt which sets how many times a message is tried before its failure is reported.
+func SetBulkSenderMaxAttempts(attempts int) BulkSenderOpt {
+	return func(bs *BulkSender) {
+		bs.maxAttempts = attempts
+	}
+}
+
+// SetBulkSenderBackoff returns a BulkSenderOpt which sets the delay before the first retry of a transient failure. It doubles on every further retry.
+func SetBulkSenderBackoff(backoff time.Duration) BulkSenderOpt {
+	return func(bs *BulkSender) {
+		bs.backoff = backoff
+	}
+}
+
+// SetBulkSenderRetryAmbiguous returns a BulkSenderOpt which sets whether failures that leave it unknown if a message was
+// accepted, see BulkResult.Ambiguous, are retried like throttling is. sendMail is not idempotent, so they are reported rather
+// than retried by default; retrying them trades possible duplicates for fewer lost messages.
+func SetBulkSenderRetryAmbiguous(retry bool) BulkSenderOpt {
+	return func(bs *BulkSender) {
+		bs.retryAmbiguous = retry
+	}
+}
+
+// SetBulkSenderSaveToSentItems returns a BulkSenderOpt which sets whether copies of sent messages are saved to the
+// sending mailbox's Sent Items folder, which they are by default.
+func SetBulkSenderSaveToSentItems(save bool) BulkSenderOpt {
+	return func(bs *BulkSender) {
+		bs.noSentItems = !save
+	}
+}
+
+// BulkSender sends a stream of messages through a pool of workers, retrying failures graph asks to be retried. When graph throttles
+// any send, every worker pauses for the Retry-After graph asked for rather than piling on more requests.
+type BulkSender struct {
+	workers        int
+	maxAttempts    int
+	backoff        time.Duration
+	noSentItems    bool
+	retryAmbiguous bool
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// NewBulkSender returns a new instance of a BulkSender with the given options set.
+func NewBulkSender(opts ...BulkSenderOpt) *BulkSender {
+	bs := &BulkSender{
+		workers:     DefaultBulkSenderWorkers,
+		maxAttempts: DefaultBulkSenderMaxAttempts,
+		backoff:     DefaultBulkSenderBackoff,
+	}
+	for _, opt := range opts {
+		opt(bs)
+	}
+	if bs.workers < 1 {
+		bs.workers = 1
+	}
+	if bs.maxAttempts < 1 {
+		bs.maxAttempts = 1
+	}
+	return bs
+}
+
+// Send sends every message received from messages until it is closed, reporting one BulkResult per message on the returned
+// channel, which is closed once all of them have been reported. Messages still queued when ctx is done are reported with ctx's error.
+func (bs *BulkSender) Send(ctx context.Context, messages <-chan *BulkMessage) <-chan *BulkResult {
+	results := make(chan *BulkResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < bs.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for message := range messages {
+				results <- bs.send(ctx, message)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (bs *BulkSender) send(ctx context.Context, message *BulkMessage) *BulkResult {
+	result := &BulkResult{ID: message.ID, Message: message.Message}
+	backoff := bs.backoff
+	for result.Attempts < bs.maxAttempts {
+		if err := bs.wait(ctx, 0); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		call := message.Session.SendMail(message.Message)
+		if bs.noSentItems {
+			call.SaveToSentItems(false)
+		}
+		result.Err = call.Do(ctx)
+		if result.Err == nil {
+			return result
+		}
+		retry, ambiguous := sendRetryable(result.Err)
+		result.Ambiguous = ambiguous
+		if ambiguous && bs.retryAmbiguous {
+			retry = true
+		}
+		if !retry || result.Attempts == bs.maxAttempts {
+			return result
+		}
+
+		delay := backoff
+		backoff *= 2
+		var graphErr *GraphError
+		// Responses without a Retry-After carry no suggestion, and fall back to the exponential backoff.
+		if errors.As(result.Err, &graphErr) && (graphErr.StatusCode == 429 || graphErr.StatusCode == 503) && graphErr.SuggestedRetryDuration > 0 {
+			delay = graphErr.SuggestedRetryDuration
+			bs.pause(delay)
+		}
+		if err := bs.wait(ctx, delay); err != nil {
+			result.Err = err
+			return result
+		}
+	}
+	return result
+}
+
+// pause holds every worker back for d.
+func (bs *BulkSender) pause(d time.Duration) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if until := time.Now().Add(d); until.After(bs.pausedUntil) {
+		bs.pausedUntil = until
+	}
+}
+
+// wait sleeps for at least d and until any pause has passed, or until ctx is done.
+func (bs *BulkSender) wait(ctx context.Context, d time.Duration) error {
+	bs.mu.Lock()
+	if paused := time.Until(bs.pausedUntil); paused > d {
+		d = paused
+	}
+	bs.mu.Unlock()
+
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendRetryable reports whether a send which failed with err can safely be retried, as graph rejected it without sending:
+// throttling, and 503s with a Retry-After. Otherwise it reports whether the failure is ambiguous, leaving it unknown whether
+// the message was sent: other server errors and network timeouts.
+func sendRetryable(err error) (retry, ambiguous bool) {
+	var graphErr *GraphError
+	if errors.As(err, &graphErr) {
+		switch {
+		case graphErr.StatusCode == 429:
+			return true, false
+		case graphErr.StatusCode == 503 && graphErr.SuggestedRetryDuration > 0:
+			return true, false
+		}
+		return false, graphErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return false, errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -3,8 +3,13 @@ package outlook
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"time"
 )
 
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
 // CalendarService manages communication with microsofts graph for calendar resources.
 type CalendarService struct {
 	session  *Session
@@ -47,7 +52,27 @@ func (clc *CalendarListCall) NextLink(link string) *CalendarListCall {
 }
 
 // Do executes the calendar list call, returning the calendar list result.
-func (clc *CalendarListCall) Do(ctx context.Context) (*CalendarListResult, error) {
+func (clc *CalendarListCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarListResult, error) {
+	var result CalendarListResult
+	if _, err := clc.do(ctx, &result, opts); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DoResponse executes the calendar list call, returning the page along with its paging and correlation metadata.
+func (clc *CalendarListCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Calendar], error) {
+	var page odataCollection[*Calendar]
+	res, err := clc.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (clc *CalendarListCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
 	params := map[string]interface{}{
 		"$top":   clc.maxResults,
 		"$count": true,
@@ -56,12 +81,7 @@ func (clc *CalendarListCall) Do(ctx context.Context) (*CalendarListResult, error
 		params["$skip"] = parsePageLink(clc.nextLink, "$skip")
 	}
 
-	var result CalendarListResult
-	if _, err := clc.service.session.Get(ctx, clc.service.basePath, params, &result); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return clc.service.session.Get(ctx, clc.service.basePath, params, result, opts...)
 }
 
 // CalendarGetCall struct allowing for fluent style configuration of calls to the calendar get endpoint.
@@ -70,7 +90,7 @@ type CalendarGetCall struct {
 	calendarID string
 }
 
-// Get returns an instance of a CalendarGetCall with the given calendarID.
+// Get returns an instance of a CalendarGetCall with the given calendarID, or the default calendar for "primary".
 func (cs *CalendarService) Get(calendarID string) *CalendarGetCall {
 	return &CalendarGetCall{
 		service:    cs,
@@ -79,10 +99,37 @@ func (cs *CalendarService) Get(calendarID string) *CalendarGetCall {
 }
 
 // Do executes the http get request to microsoft's graph api to get the call's calendar.
-func (cgc *CalendarGetCall) Do(ctx context.Context) (*Calendar, error) {
+func (cgc *CalendarGetCall) Do(ctx context.Context, opts ...RequestOption) (*Calendar, error) {
 	path := fmt.Sprintf("%s/%s", cgc.service.basePath, cgc.calendarID)
+	if cgc.calendarID == "primary" {
+		path = "/calendar"
+	}
 	calendar := Calendar{}
-	if _, err := cgc.service.session.Get(ctx, path, nil, &calendar); err != nil {
+	if _, err := cgc.service.session.Get(ctx, path, nil, &calendar, opts...); err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
+
+// Permissions returns an instance of a CalendarPermissionService for the given calendarID.
+func (cs *CalendarService) Permissions(calendarID string) CalendarPermissionServicer {
+	return NewCalendarPermissionService(cs.session, calendarID)
+}
+
+// CalendarGetDefaultCall struct allowing for fluent style configuration of calls to the default calendar endpoint.
+type CalendarGetDefaultCall struct {
+	service *CalendarService
+}
+
+// GetDefault returns an instance of a CalendarGetDefaultCall for the user's default calendar.
+func (cs *CalendarService) GetDefault() *CalendarGetDefaultCall {
+	return &CalendarGetDefaultCall{service: cs}
+}
+
+// Do executes the http get request to microsoft's graph api to get the default calendar.
+func (cgdc *CalendarGetDefaultCall) Do(ctx context.Context, opts ...RequestOption) (*Calendar, error) {
+	calendar := Calendar{}
+	if _, err := cgdc.service.session.Get(ctx, "/calendar", nil, &calendar, opts...); err != nil {
 		return nil, err
 	}
 	return &calendar, nil
@@ -109,8 +156,8 @@ func (ccc *CalendarCreateCall) Calendar(calendar *Calendar) *CalendarCreateCall
 }
 
 // Do executes the http post request to microsoft's graph api to create the call's calendar.
-func (ccc *CalendarCreateCall) Do(ctx context.Context) (*Calendar, error) {
-	if _, err := ccc.service.session.Post(ctx, ccc.service.basePath, ccc.calendar, ccc.calendar); err != nil {
+func (ccc *CalendarCreateCall) Do(ctx context.Context, opts ...RequestOption) (*Calendar, error) {
+	if _, err := ccc.service.session.Post(ctx, ccc.service.basePath, ccc.calendar, ccc.calendar, opts...); err != nil {
 		return nil, err
 	}
 	return ccc.calendar, nil
@@ -121,6 +168,7 @@ type CalendarUpdateCall struct {
 	service    *CalendarService
 	calendarID string
 	calendar   *Calendar
+	ifMatch    string
 }
 
 // Update returns an instance of a CalendarUpdateCall with the given calendarID.
@@ -138,10 +186,34 @@ func (cuc *CalendarUpdateCall) Calendar(calendar *Calendar) *CalendarUpdateCall
 	return cuc
 }
 
+// Color sets the preset color of the calendar on the call.
+func (cuc *CalendarUpdateCall) Color(color string) *CalendarUpdateCall {
+	cuc.calendar.Color = color
+	return cuc
+}
+
+// HexColor sets the color of the calendar on the call as a hex string such as "#FF0000".
+func (cuc *CalendarUpdateCall) HexColor(hex string) *CalendarUpdateCall {
+	cuc.calendar.HexColor = hex
+	return cuc
+}
+
+// IfMatch makes the update conditional on the calendar's etag still being etag, failing with an error matching ErrPreconditionFailed otherwise.
+func (cuc *CalendarUpdateCall) IfMatch(etag string) *CalendarUpdateCall {
+	cuc.ifMatch = etag
+	return cuc
+}
+
 // Do executes the http patch request to microsoft's graph api to update the call's calendar.
-func (cuc *CalendarUpdateCall) Do(ctx context.Context) (*Calendar, error) {
+func (cuc *CalendarUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*Calendar, error) {
+	if cuc.ifMatch != "" {
+		opts = append([]RequestOption{SetRequestIfMatch(cuc.ifMatch)}, opts...)
+	}
+	if cuc.calendar.HexColor != "" && !hexColorPattern.MatchString(cuc.calendar.HexColor) {
+		return nil, fmt.Errorf("invalid calendar hex color %q", cuc.calendar.HexColor)
+	}
 	path := fmt.Sprintf("%s/%s", cuc.service.basePath, cuc.calendarID)
-	if _, err := cuc.service.session.Patch(ctx, path, cuc.calendar, cuc.calendar); err != nil {
+	if _, err := cuc.service.session.Patch(ctx, path, cuc.calendar, cuc.calendar, opts...); err != nil {
 		return nil, err
 	}
 	return cuc.calendar, nil
@@ -151,6 +223,7 @@ func (cuc *CalendarUpdateCall) Do(ctx context.Context) (*Calendar, error) {
 type CalendarDeleteCall struct {
 	service    *CalendarService
 	calendarID string
+	ifMatch    string
 }
 
 // Delete returns an instance of a CalendarDeleteCall with the given calendarID.
@@ -161,11 +234,151 @@ func (cs *CalendarService) Delete(calendarID string) *CalendarDeleteCall {
 	}
 }
 
+// IfMatch makes the delete conditional on the calendar's etag still being etag, failing with an error matching ErrPreconditionFailed otherwise.
+func (cdc *CalendarDeleteCall) IfMatch(etag string) *CalendarDeleteCall {
+	cdc.ifMatch = etag
+	return cdc
+}
+
 // Do executes the http delete request to microsoft's graph api to delete the call's calendar.
-func (cdc *CalendarDeleteCall) Do(ctx context.Context) error {
+func (cdc *CalendarDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	if cdc.ifMatch != "" {
+		opts = append([]RequestOption{SetRequestIfMatch(cdc.ifMatch)}, opts...)
+	}
 	path := fmt.Sprintf("%s/%s", cdc.service.basePath, cdc.calendarID)
-	if _, err := cdc.service.session.Delete(ctx, path, nil, nil); err != nil {
+	if _, err := cdc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
 		return err
 	}
 	return nil
 }
+
+type findMeetingTimesRequest struct {
+	Attendees                 []*Attendee         `json:"attendees,omitempty"`
+	LocationConstraint        *LocationConstraint `json:"locationConstraint,omitempty"`
+	TimeConstraint            *TimeConstraint     `json:"timeConstraint,omitempty"`
+	MeetingDuration           string              `json:"meetingDuration,omitempty"`
+	MaxCandidates             int                 `json:"maxCandidates,omitempty"`
+	IsOrganizerOptional       bool                `json:"isOrganizerOptional"`
+	ReturnSuggestionReasons   bool                `json:"returnSuggestionReasons"`
+	MinimumAttendeePercentage float64             `json:"minimumAttendeePercentage,omitempty"`
+}
+
+// FindMeetingTimesCall struct allowing for fluent style configuration of calls to the findMeetingTimes endpoint.
+type FindMeetingTimesCall struct {
+	service *CalendarService
+	request *findMeetingTimesRequest
+}
+
+// FindMeetingTimes returns an instance of a FindMeetingTimesCall which suggests meeting times based on attendee availability and the given constraints.
+func (cs *CalendarService) FindMeetingTimes() *FindMeetingTimesCall {
+	return &FindMeetingTimesCall{
+		service: cs,
+		request: &findMeetingTimesRequest{},
+	}
+}
+
+// Attendees appends attendees whose availability should be considered.
+func (fmtc *FindMeetingTimesCall) Attendees(attendees ...*Attendee) *FindMeetingTimesCall {
+	fmtc.request.Attendees = append(fmtc.request.Attendees, attendees...)
+	return fmtc
+}
+
+// LocationConstraint sets the location requirements for the meeting.
+func (fmtc *FindMeetingTimesCall) LocationConstraint(constraint *LocationConstraint) *FindMeetingTimesCall {
+	fmtc.request.LocationConstraint = constraint
+	return fmtc
+}
+
+// TimeConstraint sets the time windows and activity domain the meeting must fall within.
+func (fmtc *FindMeetingTimesCall) TimeConstraint(constraint *TimeConstraint) *FindMeetingTimesCall {
+	fmtc.request.TimeConstraint = constraint
+	return fmtc
+}
+
+// MeetingDuration sets the length of the meeting. Microsoft defaults to 30 minutes.
+func (fmtc *FindMeetingTimesCall) MeetingDuration(duration time.Duration) *FindMeetingTimesCall {
+	fmtc.request.MeetingDuration = FormatISODuration(duration)
+	return fmtc
+}
+
+// MaxCandidates sets the maximum number of suggestions to return.
+func (fmtc *FindMeetingTimesCall) MaxCandidates(max int) *FindMeetingTimesCall {
+	fmtc.request.MaxCandidates = max
+	return fmtc
+}
+
+// IsOrganizerOptional sets whether the organizer's availability may be ignored.
+func (fmtc *FindMeetingTimesCall) IsOrganizerOptional(optional bool) *FindMeetingTimesCall {
+	fmtc.request.IsOrganizerOptional = optional
+	return fmtc
+}
+
+// ReturnSuggestionReasons sets whether each suggestion includes an explanation.
+func (fmtc *FindMeetingTimesCall) ReturnSuggestionReasons(reasons bool) *FindMeetingTimesCall {
+	fmtc.request.ReturnSuggestionReasons = reasons
+	return fmtc
+}
+
+// MinimumAttendeePercentage sets the minimum confidence, from 0 to 100, a suggestion must have to be returned.
+func (fmtc *FindMeetingTimesCall) MinimumAttendeePercentage(percentage float64) *FindMeetingTimesCall {
+	fmtc.request.MinimumAttendeePercentage = percentage
+	return fmtc
+}
+
+// Do executes the http post to microsoft's graph api, returning the ranked meeting time suggestions.
+func (fmtc *FindMeetingTimesCall) Do(ctx context.Context, opts ...RequestOption) (*MeetingTimeSuggestionsResult, error) {
+	var result MeetingTimeSuggestionsResult
+	if _, err := fmtc.service.session.Post(ctx, "/findMeetingTimes", fmtc.request, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+type getScheduleRequest struct {
+	Schedules                []string          `json:"schedules"`
+	StartTime                *DateTimeTimeZone `json:"startTime"`
+	EndTime                  *DateTimeTimeZone `json:"endTime"`
+	AvailabilityViewInterval int               `json:"availabilityViewInterval,omitempty"`
+}
+
+// GetScheduleCall struct allowing for fluent style configuration of calls to the getSchedule endpoint.
+type GetScheduleCall struct {
+	service   *CalendarService
+	schedules []string
+	startTime time.Time
+	endTime   time.Time
+	interval  time.Duration
+}
+
+// GetSchedule returns an instance of a GetScheduleCall reading the free/busy information of the given users, groups, or resources between start and end.
+func (cs *CalendarService) GetSchedule(schedules []string, start, end time.Time) *GetScheduleCall {
+	return &GetScheduleCall{
+		service:   cs,
+		schedules: schedules,
+		startTime: start,
+		endTime:   end,
+		interval:  30 * time.Minute,
+	}
+}
+
+// Interval sets the length of each slot in the returned availabilityView. Must be between 5 minutes and 1440 minutes, defaults to 30 minutes.
+func (gsc *GetScheduleCall) Interval(interval time.Duration) *GetScheduleCall {
+	gsc.interval = interval
+	return gsc
+}
+
+// Do executes the http post to microsoft's graph api, returning the schedule list result.
+func (gsc *GetScheduleCall) Do(ctx context.Context, opts ...RequestOption) (*ScheduleListResult, error) {
+	body := &getScheduleRequest{
+		Schedules:                gsc.schedules,
+		StartTime:                utcDateTimeTimeZone(gsc.startTime),
+		EndTime:                  utcDateTimeTimeZone(gsc.endTime),
+		AvailabilityViewInterval: int(gsc.interval / time.Minute),
+	}
+
+	var result ScheduleListResult
+	if _, err := gsc.service.session.Post(ctx, "/calendar/getSchedule", body, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
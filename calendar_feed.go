@@ -0,0 +1,222 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCalendarFeedPast how far before today a CalendarFeed includes events by default
+	DefaultCalendarFeedPast = 30 * 24 * time.Hour
+	// DefaultCalendarFeedFuture how far after today a CalendarFeed includes events by default
+	DefaultCalendarFeedFuture = 365 * 24 * time.Hour
+	// DefaultCalendarFeedPageSize the page size a CalendarFeed requests delta pages with by default
+	DefaultCalendarFeedPageSize = 100
+)
+
+// CalendarFeedOpt functions to configure options on a CalendarFeed.
+type CalendarFeedOpt func(*CalendarFeed)
+
+// SetCalendarFeedWindow returns a CalendarFeedOpt which sets how far before and after today the feed includes events.
+func SetCalendarFeedWindow(past, future time.Duration) CalendarFeedOpt {
+	return func(cf *CalendarFeed) {
+		cf.past = past
+		cf.future = future
+	}
+}
+
+// SetCalendarFeedCalendarID returns a CalendarFeedOpt which sets the calendar the feed publishes. Defaults to the primary calendar.
+func SetCalendarFeedCalendarID(calendarID string) CalendarFeedOpt {
+	return func(cf *CalendarFeed) {
+		cf.calendarID = calendarID
+	}
+}
+
+// SetCalendarFeedPageSize returns a CalendarFeedOpt which sets the page size delta pages are requested with.
+func SetCalendarFeedPageSize(pageSize int64) CalendarFeedOpt {
+	return func(cf *CalendarFeed) {
+		cf.pageSize = pageSize
+	}
+}
+
+// CalendarFeed publishes a user's calendar as an iCalendar feed, e.g. for subscribing to from other calendar systems.
+// It keeps a copy of the events within a window around today, kept current by calendarView delta queries, so rendering
+// the feed never waits on graph. The first Sync reads the whole window; following ones only read what changed, unless
+// the window moved on to another day or graph expired the delta link, in which case the window is read again.
+//
+// A CalendarFeed is an http.Handler serving the rendered feed, and is safe for concurrent use.
+type CalendarFeed struct {
+	session    *Session
+	calendarID string
+	past       time.Duration
+	future     time.Duration
+	pageSize   int64
+
+	// syncMu serializes syncs, so concurrent ones don't interleave their delta rounds.
+	syncMu sync.Mutex
+
+	mu          sync.RWMutex
+	events      map[string]*Event
+	deltaLink   string
+	windowStart time.Time
+	syncedAt    time.Time
+}
+
+// NewCalendarFeed returns a new instance of a CalendarFeed of the session's calendar. It holds no events until its first Sync.
+func NewCalendarFeed(session *Session, opts ...CalendarFeedOpt) *CalendarFeed {
+	cf := &CalendarFeed{
+		session:    session,
+		calendarID: "primary",
+		past:       DefaultCalendarFeedPast,
+		future:     DefaultCalendarFeedFuture,
+		pageSize:   DefaultCalendarFeedPageSize,
+		events:     map[string]*Event{},
+	}
+	for _, opt := range opts {
+		opt(cf)
+	}
+	return cf
+}
+
+// Sync brings the feed's events up to date with the calendar.
+func (cf *CalendarFeed) Sync(ctx context.Context) error {
+	cf.syncMu.Lock()
+	defer cf.syncMu.Unlock()
+
+	now := time.Now().UTC()
+	windowStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(-cf.past)
+	windowEnd := windowStart.Add(cf.past + cf.future)
+
+	cf.mu.RLock()
+	link := cf.deltaLink
+	if !windowStart.Equal(cf.windowStart) {
+		link = ""
+	}
+	cf.mu.RUnlock()
+
+	changes, deltaLink, err := cf.delta(ctx, windowStart, windowEnd, link)
+	if err != nil && link != "" && IsSyncStateInvalid(err) {
+		link = ""
+		changes, deltaLink, err = cf.delta(ctx, windowStart, windowEnd, link)
+	}
+	if err != nil {
+		return err
+	}
+
+	cf.mu.Lock()
+	defer cf.mu.Unlock()
+	if link == "" {
+		cf.events = map[string]*Event{}
+	}
+	for _, event := range changes {
+		if event.Removed != nil {
+			delete(cf.events, event.ID)
+			continue
+		}
+		cf.events[event.ID] = event
+	}
+	cf.deltaLink = deltaLink
+	cf.windowStart = windowStart
+	cf.syncedAt = now
+	return nil
+}
+
+// delta follows a round of delta pages from link, or reads the whole window when link is empty, returning the events
+// in the order graph reported them and the delta link to start the next round from.
+func (cf *CalendarFeed) delta(ctx context.Context, start, end time.Time, link string) ([]*Event, string, error) {
+	var events []*Event
+	for {
+		call := cf.session.Events().CalendarViewDelta(start, end).CalendarID(cf.calendarID).MaxResults(cf.pageSize)
+		if link != "" {
+			call.NextLink(link)
+		}
+		page, err := call.Do(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		events = append(events, page.Items...)
+		if page.DeltaLink != "" {
+			return events, page.DeltaLink, nil
+		}
+		link = page.NextLink
+	}
+}
+
+// Run syncs the feed immediately and then every interval until ctx is done, returning ctx's error. Failed syncs are reported
+// to the client's logger, if any, and retried on the next tick, the feed meanwhile serving the events it last synced.
+func (cf *CalendarFeed) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := cf.Sync(ctx); err != nil && ctx.Err() == nil {
+			if logger := cf.session.client.logger; logger != nil {
+				logger.Log(ctx, LogLevelWarn, "calendar feed sync failed", "calendar", cf.calendarID, "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Events returns the feed's events, ordered by start.
+func (cf *CalendarFeed) Events() []*Event {
+	cf.mu.RLock()
+	events := make([]*Event, 0, len(cf.events))
+	for _, event := range cf.events {
+		events = append(events, event)
+	}
+	cf.mu.RUnlock()
+
+	starts := make(map[*Event]time.Time, len(events))
+	for _, event := range events {
+		if event.Start != nil {
+			starts[event], _ = event.Start.ToTime()
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !starts[events[i]].Equal(starts[events[j]]) {
+			return starts[events[i]].Before(starts[events[j]])
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events
+}
+
+// SyncedAt returns when the feed last synced successfully, the zero time if it never has.
+func (cf *CalendarFeed) SyncedAt() time.Time {
+	cf.mu.RLock()
+	defer cf.mu.RUnlock()
+	return cf.syncedAt
+}
+
+// Render writes the feed's events to w as a complete VCALENDAR, see WriteICS.
+func (cf *CalendarFeed) Render(w io.Writer) error {
+	return WriteICS(w, cf.Events()...)
+}
+
+// ServeHTTP serves the rendered feed as text/calendar, with its last sync as Last-Modified.
+func (cf *CalendarFeed) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := cf.Render(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	http.ServeContent(w, r, "calendar.ics", cf.SyncedAt(), bytes.NewReader(buf.Bytes()))
+}
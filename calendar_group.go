@@ -0,0 +1,212 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+)
+
+// CalendarGroupService manages communication with microsofts graph for calendar group resources.
+type CalendarGroupService struct {
+	session  *Session
+	basePath string
+}
+
+// NewCalendarGroupService returns a new instance of a CalendarGroupService.
+func NewCalendarGroupService(session *Session) *CalendarGroupService {
+	return &CalendarGroupService{
+		session:  session,
+		basePath: "/calendarGroups",
+	}
+}
+
+// CalendarGroupListCall struct allowing for fluent style configuration of calls to the calendarGroup list endpoint.
+type CalendarGroupListCall struct {
+	service    *CalendarGroupService
+	nextLink   string
+	maxResults int64
+}
+
+// List returns a CalendarGroupListCall builder struct
+func (cgs *CalendarGroupService) List() *CalendarGroupListCall {
+	return &CalendarGroupListCall{
+		service:    cgs,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the calendar group list call.
+func (cglc *CalendarGroupListCall) MaxResults(pageSize int64) *CalendarGroupListCall {
+	cglc.maxResults = pageSize
+	return cglc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the calendar group list call.
+func (cglc *CalendarGroupListCall) NextLink(link string) *CalendarGroupListCall {
+	cglc.nextLink = link
+	return cglc
+}
+
+// Do executes the calendar group list call, returning the calendar group list result.
+func (cglc *CalendarGroupListCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarGroupListResult, error) {
+	params := map[string]interface{}{
+		"$top": cglc.maxResults,
+	}
+	if cglc.nextLink != "" {
+		params["$skip"] = parsePageLink(cglc.nextLink, "$skip")
+	}
+
+	var result CalendarGroupListResult
+	if _, err := cglc.service.session.Get(ctx, cglc.service.basePath, params, &result, opts...); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CalendarGroupGetCall struct allowing for fluent style configuration of calls to the calendarGroup get endpoint.
+type CalendarGroupGetCall struct {
+	service *CalendarGroupService
+	groupID string
+}
+
+// Get returns an instance of a CalendarGroupGetCall with the given groupID.
+func (cgs *CalendarGroupService) Get(groupID string) *CalendarGroupGetCall {
+	return &CalendarGroupGetCall{
+		service: cgs,
+		groupID: groupID,
+	}
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's calendar group.
+func (cggc *CalendarGroupGetCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarGroup, error) {
+	path := fmt.Sprintf("%s/%s", cggc.service.basePath, cggc.groupID)
+	group := CalendarGroup{}
+	if _, err := cggc.service.session.Get(ctx, path, nil, &group, opts...); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// CalendarGroupCreateCall struct allowing for fluent style configuration of calls to the calendarGroup create endpoint.
+type CalendarGroupCreateCall struct {
+	service *CalendarGroupService
+	group   *CalendarGroup
+}
+
+// Create returns an instance of a CalendarGroupCreateCall creating a group with the given name.
+func (cgs *CalendarGroupService) Create(name string) *CalendarGroupCreateCall {
+	return &CalendarGroupCreateCall{
+		service: cgs,
+		group:   &CalendarGroup{Name: name},
+	}
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's calendar group.
+func (cgcc *CalendarGroupCreateCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarGroup, error) {
+	if _, err := cgcc.service.session.Post(ctx, cgcc.service.basePath, cgcc.group, cgcc.group, opts...); err != nil {
+		return nil, err
+	}
+	return cgcc.group, nil
+}
+
+// CalendarGroupDeleteCall struct allowing for fluent style configuration of calls to the calendarGroup delete endpoint.
+type CalendarGroupDeleteCall struct {
+	service *CalendarGroupService
+	groupID string
+}
+
+// Delete returns an instance of a CalendarGroupDeleteCall with the given groupID.
+func (cgs *CalendarGroupService) Delete(groupID string) *CalendarGroupDeleteCall {
+	return &CalendarGroupDeleteCall{
+		service: cgs,
+		groupID: groupID,
+	}
+}
+
+// Do executes the http delete request to microsoft's graph api to delete the call's calendar group.
+func (cgdc *CalendarGroupDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", cgdc.service.basePath, cgdc.groupID)
+	if _, err := cgdc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CalendarGroupCalendarListCall struct allowing for fluent style configuration of calls listing the calendars within a calendar group.
+type CalendarGroupCalendarListCall struct {
+	service    *CalendarGroupService
+	groupID    string
+	nextLink   string
+	maxResults int64
+}
+
+// ListCalendars returns a CalendarGroupCalendarListCall for the calendars within the given group.
+func (cgs *CalendarGroupService) ListCalendars(groupID string) *CalendarGroupCalendarListCall {
+	return &CalendarGroupCalendarListCall{
+		service:    cgs,
+		groupID:    groupID,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the calendar group calendar list call.
+func (cgclc *CalendarGroupCalendarListCall) MaxResults(pageSize int64) *CalendarGroupCalendarListCall {
+	cgclc.maxResults = pageSize
+	return cgclc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the calendar group calendar list call.
+func (cgclc *CalendarGroupCalendarListCall) NextLink(link string) *CalendarGroupCalendarListCall {
+	cgclc.nextLink = link
+	return cgclc
+}
+
+// Do executes the calendar group calendar list call, returning the calendar list result.
+func (cgclc *CalendarGroupCalendarListCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarListResult, error) {
+	params := map[string]interface{}{
+		"$top": cgclc.maxResults,
+	}
+	if cgclc.nextLink != "" {
+		params["$skip"] = parsePageLink(cgclc.nextLink, "$skip")
+	}
+
+	path := fmt.Sprintf("%s/%s/calendars", cgclc.service.basePath, cgclc.groupID)
+
+	var result CalendarListResult
+	if _, err := cgclc.service.session.Get(ctx, path, params, &result, opts...); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CalendarGroupCalendarCreateCall struct allowing for fluent style configuration of calls creating a calendar within a calendar group.
+type CalendarGroupCalendarCreateCall struct {
+	service  *CalendarGroupService
+	groupID  string
+	calendar *Calendar
+}
+
+// CreateCalendar returns an instance of a CalendarGroupCalendarCreateCall creating a calendar within the given group.
+func (cgs *CalendarGroupService) CreateCalendar(groupID string) *CalendarGroupCalendarCreateCall {
+	return &CalendarGroupCalendarCreateCall{
+		service:  cgs,
+		groupID:  groupID,
+		calendar: &Calendar{},
+	}
+}
+
+// Calendar sets the calendar data to be created on the call.
+func (cgccc *CalendarGroupCalendarCreateCall) Calendar(calendar *Calendar) *CalendarGroupCalendarCreateCall {
+	cgccc.calendar = calendar
+	return cgccc
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's calendar within its group.
+func (cgccc *CalendarGroupCalendarCreateCall) Do(ctx context.Context, opts ...RequestOption) (*Calendar, error) {
+	path := fmt.Sprintf("%s/%s/calendars", cgccc.service.basePath, cgccc.groupID)
+	if _, err := cgccc.service.session.Post(ctx, path, cgccc.calendar, cgccc.calendar, opts...); err != nil {
+		return nil, err
+	}
+	return cgccc.calendar, nil
+}
@@ -0,0 +1,299 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+)
+
+// iTIP (RFC 5546) methods of a calendar invite.
+const (
+	CalendarInviteMethodPublish        = "PUBLISH"
+	CalendarInviteMethodRequest        = "REQUEST"
+	CalendarInviteMethodReply          = "REPLY"
+	CalendarInviteMethodAdd            = "ADD"
+	CalendarInviteMethodCancel         = "CANCEL"
+	CalendarInviteMethodRefresh        = "REFRESH"
+	CalendarInviteMethodCounter        = "COUNTER"
+	CalendarInviteMethodDeclineCounter = "DECLINECOUNTER"
+)
+
+// CalendarInviteOperation what a calendar invite asks of the recipient's calendar.
+type CalendarInviteOperation string
+
+const (
+	// CalendarInviteOperationUpsert the invite's events should be created, or updated if they already are in the calendar
+	CalendarInviteOperationUpsert CalendarInviteOperation = "upsert"
+	// CalendarInviteOperationCancel the invite's events were cancelled and should be removed from the calendar
+	CalendarInviteOperationCancel CalendarInviteOperation = "cancel"
+	// CalendarInviteOperationReply the invite is an attendee's response, carried as the status of its events' attendees,
+	// for the organizer to record
+	CalendarInviteOperationReply CalendarInviteOperation = "reply"
+	// CalendarInviteOperationNone the invite asks nothing of the calendar which can be done through graph, e.g. a COUNTER proposal
+	CalendarInviteOperationNone CalendarInviteOperation = "none"
+)
+
+// InviteUIDPropertyID the extended property ApplyInvite stores an invite's UID in, so later updates and cancellations of the
+// invite find the event it created. Graph generates its own iCalUId for created events, so the UID can't be kept there.
+const InviteUIDPropertyID = "String {00020329-0000-0000-C000-000000000046} Name x-outlook-ics-uid"
+
+// CalendarInvite an iCalendar object found in a message, such as an invitation from a sender outside of exchange, which
+// exchange delivers as a plain message with a text/calendar part or .ics attachment rather than as an event message.
+type CalendarInvite struct {
+	// Method the invite's iTIP method, one of the CalendarInviteMethod constants, empty for a plain iCalendar file.
+	Method string
+	// Events the invite's events, see ParseICS. Their UID is carried as their TransactionID.
+	Events []*Event
+	// Filename the name of the attachment the invite was found in, empty for a text/calendar body part.
+	Filename string
+}
+
+// ParseCalendarInvite parses an iCalendar stream, such as a text/calendar part, into a CalendarInvite.
+func ParseCalendarInvite(r io.Reader) (*CalendarInvite, error) {
+	method, events, err := parseICS(r)
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarInvite{Method: method, Events: events}, nil
+}
+
+// Operation maps the invite's method to what it asks of the recipient's calendar. A plain iCalendar file, without a method,
+// is treated like PUBLISH.
+func (ci *CalendarInvite) Operation() CalendarInviteOperation {
+	switch ci.Method {
+	case "", CalendarInviteMethodPublish, CalendarInviteMethodRequest, CalendarInviteMethodAdd:
+		return CalendarInviteOperationUpsert
+	case CalendarInviteMethodCancel:
+		return CalendarInviteOperationCancel
+	case CalendarInviteMethodReply:
+		return CalendarInviteOperationReply
+	}
+	return CalendarInviteOperationNone
+}
+
+// FindCalendarInvites returns the calendar invites attached to message, i.e. its file attachments with a text/calendar
+// or application/ics content type or a .ics name. Attachments must have been read with their content, e.g. with $expand=attachments.
+func FindCalendarInvites(message *Message) ([]*CalendarInvite, error) {
+	var invites []*CalendarInvite
+	for _, attachment := range message.Attachments {
+		if attachment.ODataType != "" && attachment.ODataType != AttachmentODataTypeFile {
+			continue
+		}
+		if !isCalendarContent(attachment.ContentType, attachment.Name) {
+			continue
+		}
+		invite, err := ParseCalendarInvite(bytes.NewReader(attachment.ContentBytes))
+		if err != nil {
+			return nil, fmt.Errorf("invite: attachment %q: %w", attachment.Name, err)
+		}
+		invite.Filename = attachment.Name
+		invites = append(invites, invite)
+	}
+	return invites, nil
+}
+
+// FindCalendarInvitesMIME returns the calendar invites in an RFC 5322 MIME message, e.g. as read by MessageService.GetMIME,
+// found in its text/calendar parts and .ics attachments at any depth.
+func FindCalendarInvitesMIME(r io.Reader) ([]*CalendarInvite, error) {
+	message, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("invite: %w", err)
+	}
+	var invites []*CalendarInvite
+	err = findMIMECalendarInvites(textproto.MIMEHeader(message.Header), message.Body, &invites)
+	return invites, err
+}
+
+func findMIMECalendarInvites(header textproto.MIMEHeader, body io.Reader, invites *[]*CalendarInvite) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("invite: %w", err)
+			}
+			if err := findMIMECalendarInvites(part.Header, part, invites); err != nil {
+				return err
+			}
+		}
+	}
+
+	filename := params["name"]
+	if _, dispositionParams, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil && dispositionParams["filename"] != "" {
+		filename = dispositionParams["filename"]
+	}
+	if !isCalendarContent(mediaType, filename) {
+		return nil
+	}
+
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, &base64LineReader{r: body})
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+	invite, err := ParseCalendarInvite(body)
+	if err != nil {
+		return fmt.Errorf("invite: part %q: %w", filename, err)
+	}
+	// A text/calendar alternative of the body is the invite itself rather than an attachment.
+	if !strings.EqualFold(strings.SplitN(header.Get("Content-Disposition"), ";", 2)[0], "attachment") {
+		filename = ""
+	}
+	invite.Filename = filename
+	*invites = append(*invites, invite)
+	return nil
+}
+
+func isCalendarContent(contentType, filename string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch strings.ToLower(mediaType) {
+	case "text/calendar", "application/ics":
+		return true
+	}
+	return strings.HasSuffix(strings.ToLower(filename), ".ics")
+}
+
+// base64LineReader drops the line breaks of base64 encoded MIME content, which encoding/base64 does not skip over on its own
+// when decoding a stream.
+type base64LineReader struct {
+	r io.Reader
+}
+
+func (blr *base64LineReader) Read(p []byte) (int, error) {
+	for {
+		n, err := blr.r.Read(p)
+		kept := 0
+		for _, b := range p[:n] {
+			if b != '\r' && b != '\n' && b != ' ' && b != '\t' {
+				p[kept] = b
+				kept++
+			}
+		}
+		if kept > 0 || err != nil {
+			return kept, err
+		}
+	}
+}
+
+// EventApplyInviteCall struct allowing for fluent style configuration of applying a calendar invite to a calendar.
+type EventApplyInviteCall struct {
+	service    *EventService
+	calendarID string
+	invite     *CalendarInvite
+}
+
+// ApplyInvite returns an instance of an EventApplyInviteCall carrying out what the invite asks of the user's primary calendar:
+// creating or updating its events for a PUBLISH, REQUEST, or ADD, and deleting them for a CANCEL. Events are matched to earlier
+// invites by their UID, which is stored on created events as the InviteUIDPropertyID extended property.
+func (es *EventService) ApplyInvite(invite *CalendarInvite) *EventApplyInviteCall {
+	return &EventApplyInviteCall{
+		service:    es,
+		calendarID: "primary",
+		invite:     invite,
+	}
+}
+
+// CalendarID sets the calendar the invite is applied to. Defaults to the primary calendar.
+func (eaic *EventApplyInviteCall) CalendarID(calendarID string) *EventApplyInviteCall {
+	eaic.calendarID = calendarID
+	return eaic
+}
+
+// Do executes the call, returning the events created or updated. Invites whose operation is not
+// CalendarInviteOperationUpsert or CalendarInviteOperationCancel can't be applied and fail.
+//
+// Events are written without their attendees, as exchange would otherwise send meeting requests for them from the user's own
+// mailbox; the invite's attendees remain on its Events. Events overriding a single occurrence of a series, those with an
+// OriginalStart, are skipped rather than applied to the series as a whole, and are left to the caller.
+func (eaic *EventApplyInviteCall) Do(ctx context.Context, opts ...RequestOption) ([]*Event, error) {
+	operation := eaic.invite.Operation()
+	if operation != CalendarInviteOperationUpsert && operation != CalendarInviteOperationCancel {
+		return nil, fmt.Errorf("invite: a %s can't be applied to a calendar", eaic.invite.Method)
+	}
+
+	var applied []*Event
+	for _, event := range eaic.invite.Events {
+		if event.OriginalStart != "" {
+			continue
+		}
+		uid := event.TransactionID
+		if uid == "" {
+			return applied, fmt.Errorf("invite: event %q has no UID", event.Subject)
+		}
+		existing, err := eaic.find(ctx, uid, opts)
+		if err != nil {
+			return applied, err
+		}
+
+		switch {
+		case operation == CalendarInviteOperationCancel && existing == nil:
+			continue
+		case operation == CalendarInviteOperationCancel:
+			if err := eaic.service.Delete(eaic.calendarID, existing.ID).Do(ctx, opts...); err != nil && !IsNotFound(err) {
+				return applied, err
+			}
+		case existing == nil:
+			created := *event
+			created.Attendees = nil
+			created.SingleValueExtendedProperties = []*SingleValueExtendedProperty{{ID: InviteUIDPropertyID, Value: uid}}
+			result, err := eaic.service.Create(eaic.calendarID).Event(&created).Do(ctx, opts...)
+			if err != nil {
+				return applied, err
+			}
+			applied = append(applied, result)
+		default:
+			// The organizer and transaction id are fixed once an event is created, so an update only carries what an invite can change.
+			updated := *event
+			updated.ID = existing.ID
+			updated.Organizer = nil
+			updated.Attendees = nil
+			updated.TransactionID = ""
+			result, err := eaic.service.Update(eaic.calendarID).Event(&updated).Do(ctx, opts...)
+			if err != nil {
+				return applied, err
+			}
+			applied = append(applied, result)
+		}
+	}
+	return applied, nil
+}
+
+// find returns the event created from an invite with the given UID, or nil if there is none.
+func (eaic *EventApplyInviteCall) find(ctx context.Context, uid string, opts []RequestOption) (*Event, error) {
+	path := eaic.service.basePath
+	if eaic.calendarID != "primary" {
+		path = fmt.Sprintf("/calendars/%s%s", eaic.calendarID, eaic.service.basePath)
+	}
+	params := map[string]interface{}{
+		"$top":    1,
+		"$select": "id",
+		"$filter": fmt.Sprintf("singleValueExtendedProperties/Any(ep: ep/id eq '%s' and ep/value eq '%s')",
+			InviteUIDPropertyID, strings.ReplaceAll(uid, "'", "''")),
+	}
+
+	var result EventListResult
+	if _, err := eaic.service.session.Get(ctx, path, params, &result, opts...); err != nil {
+		return nil, err
+	}
+	if len(result.Value) == 0 {
+		return nil, nil
+	}
+	return result.Value[0], nil
+}
@@ -0,0 +1,119 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+)
+
+// CalendarPermissionService manages communication with microsofts graph for the sharing permissions of a calendar.
+type CalendarPermissionService struct {
+	session  *Session
+	basePath string
+}
+
+// NewCalendarPermissionService returns a new instance of a CalendarPermissionService for the given calendarID. Use "primary" for the user's default calendar.
+func NewCalendarPermissionService(session *Session, calendarID string) *CalendarPermissionService {
+	var basePath string
+	if calendarID == "primary" {
+		basePath = "/calendar/calendarPermissions"
+	} else {
+		basePath = fmt.Sprintf("/calendars/%s/calendarPermissions", calendarID)
+	}
+	return &CalendarPermissionService{
+		session:  session,
+		basePath: basePath,
+	}
+}
+
+// CalendarPermissionListCall struct allowing for fluent style configuration of calls to the calendarPermission list endpoint.
+type CalendarPermissionListCall struct {
+	service *CalendarPermissionService
+}
+
+// List returns a CalendarPermissionListCall builder struct
+func (cps *CalendarPermissionService) List() *CalendarPermissionListCall {
+	return &CalendarPermissionListCall{service: cps}
+}
+
+// Do executes the calendar permission list call, returning the calendar permission list result.
+func (cplc *CalendarPermissionListCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarPermissionListResult, error) {
+	var result CalendarPermissionListResult
+	if _, err := cplc.service.session.Get(ctx, cplc.service.basePath, nil, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CalendarPermissionCreateCall struct allowing for fluent style configuration of calls to the calendarPermission create endpoint.
+type CalendarPermissionCreateCall struct {
+	service    *CalendarPermissionService
+	permission *CalendarPermission
+}
+
+// Create returns an instance of a CalendarPermissionCreateCall granting role on the calendar to the given email address.
+func (cps *CalendarPermissionService) Create(emailAddress *EmailAddress, role string) *CalendarPermissionCreateCall {
+	return &CalendarPermissionCreateCall{
+		service: cps,
+		permission: &CalendarPermission{
+			EmailAddress: emailAddress,
+			Role:         role,
+		},
+	}
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's calendar permission.
+func (cpcc *CalendarPermissionCreateCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarPermission, error) {
+	if _, err := cpcc.service.session.Post(ctx, cpcc.service.basePath, cpcc.permission, cpcc.permission, opts...); err != nil {
+		return nil, err
+	}
+	return cpcc.permission, nil
+}
+
+// CalendarPermissionUpdateCall struct allowing for fluent style configuration of calls to the calendarPermission update endpoint.
+type CalendarPermissionUpdateCall struct {
+	service      *CalendarPermissionService
+	permissionID string
+	role         string
+}
+
+// Update returns an instance of a CalendarPermissionUpdateCall changing the role of the given permission. Only the role may be updated.
+func (cps *CalendarPermissionService) Update(permissionID, role string) *CalendarPermissionUpdateCall {
+	return &CalendarPermissionUpdateCall{
+		service:      cps,
+		permissionID: permissionID,
+		role:         role,
+	}
+}
+
+// Do executes the http patch request to microsoft's graph api to update the call's calendar permission.
+func (cpuc *CalendarPermissionUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*CalendarPermission, error) {
+	path := fmt.Sprintf("%s/%s", cpuc.service.basePath, cpuc.permissionID)
+	permission := &CalendarPermission{Role: cpuc.role}
+	if _, err := cpuc.service.session.Patch(ctx, path, permission, permission, opts...); err != nil {
+		return nil, err
+	}
+	return permission, nil
+}
+
+// CalendarPermissionDeleteCall struct allowing for fluent style configuration of calls to the calendarPermission delete endpoint.
+type CalendarPermissionDeleteCall struct {
+	service      *CalendarPermissionService
+	permissionID string
+}
+
+// Delete returns an instance of a CalendarPermissionDeleteCall revoking the given permission.
+func (cps *CalendarPermissionService) Delete(permissionID string) *CalendarPermissionDeleteCall {
+	return &CalendarPermissionDeleteCall{
+		service:      cps,
+		permissionID: permissionID,
+	}
+}
+
+// Do executes the http delete request to microsoft's graph api to delete the call's calendar permission.
+func (cpdc *CalendarPermissionDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", cpdc.service.basePath, cpdc.permissionID)
+	if _, err := cpdc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
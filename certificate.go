@@ -0,0 +1,143 @@
+package outlook
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// clientAssertionLifetime how long a signed client assertion remains valid for
+	clientAssertionLifetime = 10 * time.Minute
+)
+
+// NewCertificateTokenSource returns a TokenSource which acquires app-only tokens for the given tenant using the client credentials grant,
+// authenticating with a client assertion signed by the private key of the certificate registered on the application.
+func NewCertificateTokenSource(ctx context.Context, tenant, clientID string, cert *x509.Certificate, key crypto.Signer, opts ...AuthOpt) (oauth2.TokenSource, error) {
+	if cert == nil {
+		return nil, fmt.Errorf("no certificate provided")
+	}
+	if key == nil {
+		return nil, fmt.Errorf("no private key provided")
+	}
+	// Only the public key is checked, so signers backed by an HSM or a key management service can be used too.
+	if _, ok := key.Public().(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("certificate credentials require an RSA key, got %T", key.Public())
+	}
+
+	options := newAuthOptions(opts)
+	tokenURL := options.tenantAuthority(tenant).TokenURL()
+	return NewReuseTokenSource(nil, &assertionTokenSource{
+		ctx:      ctx,
+		tokenURL: tokenURL,
+		clientID: clientID,
+		scope:    options.appScope,
+		assertion: func(context.Context) (string, error) {
+			return signClientAssertion(cert, key, clientID, tokenURL)
+		},
+	}), nil
+}
+
+// ParseCertificatePEM parses PEM data containing both a certificate and its private key, as exported for an application credential.
+func ParseCertificatePEM(data []byte) (*x509.Certificate, crypto.Signer, error) {
+	var cert *x509.Certificate
+	var key crypto.Signer
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "CERTIFICATE":
+			if cert != nil {
+				continue
+			}
+			parsed, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			cert = parsed
+		case "RSA PRIVATE KEY":
+			parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			key = parsed
+		case "PRIVATE KEY":
+			parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			signer, ok := parsed.(crypto.Signer)
+			if !ok {
+				return nil, nil, fmt.Errorf("unsupported private key type %T", parsed)
+			}
+			key = signer
+		}
+	}
+	if cert == nil {
+		return nil, nil, fmt.Errorf("no certificate found in PEM data")
+	}
+	if key == nil {
+		return nil, nil, fmt.Errorf("no private key found in PEM data")
+	}
+	return cert, key, nil
+}
+
+// signClientAssertion builds the RS256 signed JWT microsoft accepts in place of a client secret.
+func signClientAssertion(cert *x509.Certificate, key crypto.Signer, clientID, audience string) (string, error) {
+	sha1Thumbprint := sha1.Sum(cert.Raw)
+	sha256Thumbprint := sha256.Sum256(cert.Raw)
+	header := map[string]interface{}{
+		"alg":      "RS256",
+		"typ":      "JWT",
+		"x5t":      base64.RawURLEncoding.EncodeToString(sha1Thumbprint[:]),
+		"x5t#S256": base64.RawURLEncoding.EncodeToString(sha256Thumbprint[:]),
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := map[string]interface{}{
+		"aud": audience,
+		"iss": clientID,
+		"sub": clientID,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"nbf": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+	}
+
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(encodedHeader) + "." + base64.RawURLEncoding.EncodeToString(encodedClaims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,92 @@
+package outlook
+
+import (
+	"sync"
+	"time"
+)
+
+// SetClientCircuitBreaker returns a ClientOpt function which makes the client fail fast with ErrCircuitOpen once failureThreshold
+// consecutive requests fail with a 5xx or a transport error such as a timeout. After cooldown a single probe request is let through:
+// if it succeeds the circuit closes again, otherwise it stays open for another cooldown.
+func SetClientCircuitBreaker(failureThreshold int, cooldown time.Duration) ClientOpt {
+	return func(c *Client) {
+		c.breaker = &circuitBreaker{
+			threshold: failureThreshold,
+			cooldown:  cooldown,
+		}
+	}
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// allow reports whether a request may be sent, moving an open circuit whose cooldown has passed to half-open and admitting the caller as its probe.
+func (cb *circuitBreaker) allow() bool {
+	if cb == nil {
+		return true
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.cooldown {
+			return false
+		}
+		cb.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// A probe is already in flight.
+		return false
+	}
+	return true
+}
+
+// record updates the circuit with the outcome of a request allow admitted.
+func (cb *circuitBreaker) record(failed bool) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !failed {
+		cb.state = circuitClosed
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.threshold {
+		cb.state = circuitOpen
+		cb.openedAt = time.Now()
+	}
+}
+
+// abandon releases a request allow admitted whose outcome is unknown, letting the next request probe a half-open circuit instead.
+func (cb *circuitBreaker) abandon() {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == circuitHalfOpen {
+		cb.state = circuitOpen
+	}
+}
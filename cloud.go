@@ -0,0 +1,79 @@
+package outlook
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Cloud describes a microsoft cloud instance: where its graph api is hosted and which identity platform issues tokens for it.
+type Cloud struct {
+	Name          string
+	GraphHost     string
+	AuthorityHost string
+}
+
+// Microsoft cloud instances.
+var (
+	// CloudGlobal the worldwide microsoft cloud
+	CloudGlobal = Cloud{
+		Name:          "global",
+		GraphHost:     "https://graph.microsoft.com",
+		AuthorityHost: "https://login.microsoftonline.com",
+	}
+	// CloudUSGovHigh the US Government L4 (GCC High) cloud
+	CloudUSGovHigh = Cloud{
+		Name:          "usgovhigh",
+		GraphHost:     "https://graph.microsoft.us",
+		AuthorityHost: "https://login.microsoftonline.us",
+	}
+	// CloudUSGovDoD the US Government L5 (DoD) cloud
+	CloudUSGovDoD = Cloud{
+		Name:          "usgovdod",
+		GraphHost:     "https://dod-graph.microsoft.us",
+		AuthorityHost: "https://login.microsoftonline.us",
+	}
+	// CloudChina the china cloud operated by 21Vianet
+	CloudChina = Cloud{
+		Name:          "china",
+		GraphHost:     "https://microsoftgraph.chinacloudapi.cn",
+		AuthorityHost: "https://login.chinacloudapi.cn",
+	}
+)
+
+// BaseURL returns the root url of the cloud's v1.0 graph api.
+func (c Cloud) BaseURL() string {
+	return strings.TrimSuffix(c.GraphHost, "/") + "/v1.0"
+}
+
+// AppScope returns the .default scope of the cloud's graph api, requested by app-only clients.
+func (c Cloud) AppScope() string {
+	return strings.TrimSuffix(c.GraphHost, "/") + "/.default"
+}
+
+// Authority returns the cloud's authority for the given tenant.
+func (c Cloud) Authority(tenant string) Authority {
+	if tenant == "" {
+		tenant = TenantCommon
+	}
+	return Authority{Host: c.AuthorityHost, Tenant: tenant, GraphHost: c.GraphHost}
+}
+
+// SetAuthCloud returns an AuthOpt which acquires tokens from the cloud's identity platform for the cloud's graph api.
+func SetAuthCloud(cloud Cloud) AuthOpt {
+	return func(o *authOptions) {
+		o.authority.Host = cloud.AuthorityHost
+		o.authority.GraphHost = cloud.GraphHost
+		o.appScope = cloud.AppScope()
+	}
+}
+
+// NewClientForCloud returns a new instance of a Client which calls the graph api of the given cloud, with the given options set.
+// Token sources used with the client must be configured for the same cloud, e.g. with SetAuthCloud.
+func NewClientForCloud(cloud Cloud, opts ...ClientOpt) (*Client, error) {
+	baseURL, err := url.Parse(cloud.BaseURL())
+	if err != nil {
+		return nil, err
+	}
+	opts = append([]ClientOpt{func(c *Client) { c.baseURL = baseURL }}, opts...)
+	return NewClient(opts...)
+}
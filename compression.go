@@ -0,0 +1,67 @@
+package outlook
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SetClientRequestCompression returns a ClientOpt function which gzips request bodies of at least minSize bytes.
+// Off by default; large bodies such as messages with inline attachments benefit the most.
+func SetClientRequestCompression(minSize int) ClientOpt {
+	return func(c *Client) {
+		c.compressAbove = minSize
+	}
+}
+
+// compressBody gzips body when it reaches the client's threshold, returning whether it did.
+func (client *Client) compressBody(body *bytes.Buffer) (*bytes.Buffer, bool, error) {
+	if client.compressAbove <= 0 || body.Len() < client.compressAbove {
+		return body, false, nil
+	}
+
+	compressed := new(bytes.Buffer)
+	zw := gzip.NewWriter(compressed)
+	if _, err := zw.Write(body.Bytes()); err != nil {
+		return nil, false, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, false, err
+	}
+	return compressed, true, nil
+}
+
+// decompressResponse transparently gunzips res's body. Since the client asks for gzip itself, net/http leaves decompression to us.
+func decompressResponse(res *http.Response) (*http.Response, error) {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return res, nil
+	}
+
+	zr, err := gzip.NewReader(res.Body)
+	if err != nil {
+		// An empty body, e.g. on a 204, has no gzip header to read.
+		if err == io.EOF {
+			return res, nil
+		}
+		res.Body.Close()
+		return nil, err
+	}
+	res.Body = &gzipReadCloser{Reader: zr, body: res.Body}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+	return res, nil
+}
+
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g *gzipReadCloser) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
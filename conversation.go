@@ -0,0 +1,278 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConversationService manages communication with microsofts graph for the conversations, threads, and posts of a microsoft 365
+// group's mailbox. It is only usable with a group session, see Session.ForGroup.
+type ConversationService struct {
+	session  *Session
+	basePath string
+}
+
+// NewConversationService returns a new instance of a ConversationService.
+func NewConversationService(session *Session) *ConversationService {
+	return &ConversationService{
+		session:  session,
+		basePath: "/conversations",
+	}
+}
+
+// ConversationListCall struct allowing for fluent style configuration of calls to the group conversation list endpoint.
+type ConversationListCall struct {
+	service    *ConversationService
+	nextLink   string
+	maxResults int64
+}
+
+// List returns a ConversationListCall builder struct
+func (cs *ConversationService) List() *ConversationListCall {
+	return &ConversationListCall{
+		service:    cs,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the conversation list call.
+func (clc *ConversationListCall) MaxResults(pageSize int64) *ConversationListCall {
+	clc.maxResults = pageSize
+	return clc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the conversation list call.
+func (clc *ConversationListCall) NextLink(link string) *ConversationListCall {
+	clc.nextLink = link
+	return clc
+}
+
+// Do executes the conversation list call, returning the conversation list result.
+func (clc *ConversationListCall) Do(ctx context.Context, opts ...RequestOption) (*ConversationListResult, error) {
+	params := map[string]interface{}{
+		"$top": clc.maxResults,
+	}
+	if clc.nextLink != "" {
+		params["$skip"] = parsePageLink(clc.nextLink, "$skip")
+	}
+
+	var result ConversationListResult
+	if _, err := clc.service.session.Get(ctx, clc.service.basePath, params, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ConversationGetCall struct allowing for fluent style configuration of calls to the group conversation get endpoint.
+type ConversationGetCall struct {
+	service        *ConversationService
+	conversationID string
+}
+
+// Get returns an instance of a ConversationGetCall with the given conversationID.
+func (cs *ConversationService) Get(conversationID string) *ConversationGetCall {
+	return &ConversationGetCall{
+		service:        cs,
+		conversationID: conversationID,
+	}
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's conversation.
+func (cgc *ConversationGetCall) Do(ctx context.Context, opts ...RequestOption) (*Conversation, error) {
+	path := fmt.Sprintf("%s/%s", cgc.service.basePath, cgc.conversationID)
+	conversation := Conversation{}
+	if _, err := cgc.service.session.Get(ctx, path, nil, &conversation, opts...); err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+// ConversationCreateCall struct allowing for fluent style configuration of calls to the group conversation create endpoint.
+type ConversationCreateCall struct {
+	service      *ConversationService
+	conversation *Conversation
+}
+
+// Create returns an instance of a ConversationCreateCall starting a new conversation in the group on topic, whose first
+// post has the given body. The post is delivered to the group's members as email.
+func (cs *ConversationService) Create(topic string, body *MessageBody) *ConversationCreateCall {
+	return &ConversationCreateCall{
+		service: cs,
+		conversation: &Conversation{
+			Topic:   topic,
+			Threads: []*ConversationThread{{Posts: []*Post{{Body: body}}}},
+		},
+	}
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's conversation, returning it with its ids.
+func (ccc *ConversationCreateCall) Do(ctx context.Context, opts ...RequestOption) (*Conversation, error) {
+	var conversation Conversation
+	if _, err := ccc.service.session.Post(ctx, ccc.service.basePath, ccc.conversation, &conversation, opts...); err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+// ConversationDeleteCall struct allowing for fluent style configuration of calls to the group conversation delete endpoint.
+type ConversationDeleteCall struct {
+	service        *ConversationService
+	conversationID string
+}
+
+// Delete returns an instance of a ConversationDeleteCall removing the given conversation and every thread in it.
+func (cs *ConversationService) Delete(conversationID string) *ConversationDeleteCall {
+	return &ConversationDeleteCall{
+		service:        cs,
+		conversationID: conversationID,
+	}
+}
+
+// Do executes the http delete request to microsoft's graph api to delete the call's conversation.
+func (cdc *ConversationDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", cdc.service.basePath, cdc.conversationID)
+	if _, err := cdc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConversationThreadListCall struct allowing for fluent style configuration of calls to the group thread list endpoint.
+type ConversationThreadListCall struct {
+	service        *ConversationService
+	conversationID string
+	nextLink       string
+	maxResults     int64
+}
+
+// ListThreads returns a ConversationThreadListCall for the threads of the given conversation, or of every conversation in
+// the group when conversationID is empty.
+func (cs *ConversationService) ListThreads(conversationID string) *ConversationThreadListCall {
+	return &ConversationThreadListCall{
+		service:        cs,
+		conversationID: conversationID,
+		maxResults:     10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the thread list call.
+func (ctlc *ConversationThreadListCall) MaxResults(pageSize int64) *ConversationThreadListCall {
+	ctlc.maxResults = pageSize
+	return ctlc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the thread list call.
+func (ctlc *ConversationThreadListCall) NextLink(link string) *ConversationThreadListCall {
+	ctlc.nextLink = link
+	return ctlc
+}
+
+// Do executes the thread list call, returning the thread list result.
+func (ctlc *ConversationThreadListCall) Do(ctx context.Context, opts ...RequestOption) (*ConversationThreadListResult, error) {
+	params := map[string]interface{}{
+		"$top": ctlc.maxResults,
+	}
+	if ctlc.nextLink != "" {
+		params["$skip"] = parsePageLink(ctlc.nextLink, "$skip")
+	}
+
+	path := "/threads"
+	if ctlc.conversationID != "" {
+		path = fmt.Sprintf("%s/%s/threads", ctlc.service.basePath, ctlc.conversationID)
+	}
+
+	var result ConversationThreadListResult
+	if _, err := ctlc.service.session.Get(ctx, path, params, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ConversationPostListCall struct allowing for fluent style configuration of calls to the group thread post list endpoint.
+type ConversationPostListCall struct {
+	service    *ConversationService
+	threadID   string
+	nextLink   string
+	maxResults int64
+}
+
+// ListPosts returns a ConversationPostListCall for the posts of the given thread, oldest first.
+func (cs *ConversationService) ListPosts(threadID string) *ConversationPostListCall {
+	return &ConversationPostListCall{
+		service:    cs,
+		threadID:   threadID,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the post list call.
+func (cplc *ConversationPostListCall) MaxResults(pageSize int64) *ConversationPostListCall {
+	cplc.maxResults = pageSize
+	return cplc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the post list call.
+func (cplc *ConversationPostListCall) NextLink(link string) *ConversationPostListCall {
+	cplc.nextLink = link
+	return cplc
+}
+
+// Do executes the post list call, returning the post list result.
+func (cplc *ConversationPostListCall) Do(ctx context.Context, opts ...RequestOption) (*PostListResult, error) {
+	params := map[string]interface{}{
+		"$top": cplc.maxResults,
+	}
+	if cplc.nextLink != "" {
+		params["$skip"] = parsePageLink(cplc.nextLink, "$skip")
+	}
+
+	var result PostListResult
+	path := fmt.Sprintf("/threads/%s/posts", cplc.threadID)
+	if _, err := cplc.service.session.Get(ctx, path, params, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ConversationReplyCall struct allowing for fluent style configuration of calls replying to a group thread or post.
+type ConversationReplyCall struct {
+	service  *ConversationService
+	threadID string
+	postID   string
+	post     *Post
+}
+
+// Reply returns an instance of a ConversationReplyCall adding a post with the given body to the thread, delivered to the
+// thread's recipients as email.
+func (cs *ConversationService) Reply(threadID string, body *MessageBody) *ConversationReplyCall {
+	return &ConversationReplyCall{
+		service:  cs,
+		threadID: threadID,
+		post:     &Post{Body: body},
+	}
+}
+
+// InReplyTo makes the reply a reply to the given post of the thread, quoting it, rather than to the thread as a whole.
+func (crc *ConversationReplyCall) InReplyTo(postID string) *ConversationReplyCall {
+	crc.postID = postID
+	return crc
+}
+
+// Attachments adds attachments to the reply.
+func (crc *ConversationReplyCall) Attachments(attachments ...*Attachment) *ConversationReplyCall {
+	crc.post.Attachments = append(crc.post.Attachments, attachments...)
+	return crc
+}
+
+// Do executes the http post request to microsoft's graph api to reply. Graph accepts the reply without returning the new post.
+func (crc *ConversationReplyCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("/threads/%s/reply", crc.threadID)
+	if crc.postID != "" {
+		path = fmt.Sprintf("/threads/%s/posts/%s/reply", crc.threadID, crc.postID)
+	}
+	body := map[string]interface{}{"post": crc.post}
+	if _, err := crc.service.session.Post(ctx, path, body, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
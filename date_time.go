@@ -0,0 +1,63 @@
+package outlook
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateTimeTimeZoneFormat the layout of a DateTimeTimeZone's DateTime, a wall clock time interpreted in its Timezone.
+const DateTimeTimeZoneFormat = "2006-01-02T15:04:05"
+
+// dateTimeTimeZoneLayouts the layouts graph returns date times in, with its seven digits of fractional seconds or, rarely, an offset.
+var dateTimeTimeZoneLayouts = []string{"2006-01-02T15:04:05.9999999", time.RFC3339Nano}
+
+// NewDateTimeTimeZone returns t as a DateTimeTimeZone in t's own time zone, or in UTC for times in time.Local, whose name graph would not know.
+func NewDateTimeTimeZone(t time.Time) *DateTimeTimeZone {
+	if t.Location() == time.Local || t.Location() == time.UTC {
+		t = t.UTC()
+		return &DateTimeTimeZone{
+			DateTime: t.Format(DateTimeTimeZoneFormat),
+			Timezone: "UTC",
+		}
+	}
+	return &DateTimeTimeZone{
+		DateTime: t.Format(DateTimeTimeZoneFormat),
+		Timezone: t.Location().String(),
+	}
+}
+
+// newAllDayDateTimeTimeZone returns midnight of t's calendar date as a DateTimeTimeZone, rather than the instant t is, which
+// for times in time.Local would no longer be midnight once converted to UTC. The date is kept in t's time zone, or in UTC for
+// time.Local, which is just as good for all day events as only their date counts.
+func newAllDayDateTimeTimeZone(t time.Time) *DateTimeTimeZone {
+	timezone := "UTC"
+	if t.Location() != time.Local && t.Location() != time.UTC {
+		timezone = t.Location().String()
+	}
+	return &DateTimeTimeZone{
+		DateTime: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Format(DateTimeTimeZoneFormat),
+		Timezone: timezone,
+	}
+}
+
+// ToTime returns the date time as a time.Time in its time zone, which may be a Windows name or an IANA identifier. An empty
+// time zone is taken as UTC, and an offset in the date time, which graph includes in a few responses, wins over the time zone.
+func (dt *DateTimeTimeZone) ToTime() (time.Time, error) {
+	if dt == nil || dt.DateTime == "" {
+		return time.Time{}, fmt.Errorf("outlook: empty date time")
+	}
+
+	loc := time.UTC
+	if dt.Timezone != "" {
+		var err error
+		if loc, err = LoadTimeZone(dt.Timezone); err != nil {
+			return time.Time{}, err
+		}
+	}
+	for _, layout := range dateTimeTimeZoneLayouts {
+		if t, err := time.ParseInLocation(layout, dt.DateTime, loc); err == nil {
+			return t.In(loc), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("outlook: invalid date time %q", dt.DateTime)
+}
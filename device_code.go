@@ -0,0 +1,51 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// DeviceCodePrompt is invoked with the user code and verification uri which must be shown to the user so they can sign in on another device.
+type DeviceCodePrompt func(response *oauth2.DeviceAuthResponse) error
+
+// NewDeviceCodeTokenSource runs the device authorization grant for a public client application: it requests a user code,
+// hands it to prompt for display, then polls the token endpoint until the user signs in, the code expires, or ctx is cancelled.
+// The returned TokenSource refreshes the resulting token automatically. scopes defaults to DefaultScopes when empty.
+// tenant is interpreted as by NewAuthority, on the host of any authority or cloud configured through opts.
+// When a token cache is configured with SetAuthTokenCache, a cached token is used instead of prompting whenever possible.
+func NewDeviceCodeTokenSource(ctx context.Context, tenant, clientID string, scopes []string, prompt DeviceCodePrompt, opts ...AuthOpt) (oauth2.TokenSource, error) {
+	if prompt == nil {
+		return nil, fmt.Errorf("a device code prompt is required")
+	}
+	if len(scopes) == 0 {
+		scopes = DefaultScopes.Strings()
+	}
+
+	options := newAuthOptions(opts)
+	config := &oauth2.Config{
+		ClientID: clientID,
+		Endpoint: options.tenantAuthority(tenant).Endpoint(),
+		Scopes:   scopes,
+	}
+
+	if restored, err := options.restore(ctx, config); err != nil || restored != nil {
+		return restored, err
+	}
+
+	response, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("device code request failed: %w", err)
+	}
+	if err := prompt(response); err != nil {
+		return nil, err
+	}
+
+	token, err := config.DeviceAccessToken(ctx, response)
+	if err != nil {
+		return nil, fmt.Errorf("device code sign in failed: %w", err)
+	}
+
+	return options.wrap(ctx, newRefreshTokenSource(ctx, config, token), nil), nil
+}
@@ -0,0 +1,11 @@
+// Package outlook is a client for the mail and calendar endpoints of microsoft's graph api.
+//
+// Requests are made through a Session, created from a Client and a token source, whose services, such as Messages and
+// Events, return calls which are configured fluently and executed with Do.
+//
+// # Testing
+//
+// The supported test double is outlooktest.Server, an in-memory fake of the graph endpoints this package uses: sessions
+// created with its NewSession run every call against it, so code under test exercises the real calls without a tenant.
+// The Servicer interfaces allow depending on the services abstractly, but their calls can't be stubbed without a session.
+package outlook
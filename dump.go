@@ -0,0 +1,98 @@
+package outlook
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+// redactedHeaders headers whose values are never written to a debug dump.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+var (
+	// secretJSONPattern matches the values of json fields which carry credentials.
+	secretJSONPattern = regexp.MustCompile(`("(?i:access_token|refresh_token|id_token|client_secret|client_assertion|assertion|password)"\s*:\s*)"[^"]*"`)
+	// secretFormPattern matches the values of form fields which carry credentials.
+	secretFormPattern = regexp.MustCompile(`((?:^|&)(?i:access_token|refresh_token|id_token|client_secret|client_assertion|assertion|password|code)=)[^&]*`)
+)
+
+// SetClientDebugDump returns a ClientOpt function which writes every request and response the client sends, headers and bodies included,
+// to w. Authorization headers and credentials in bodies are redacted, but message contents are not, so dumps should still be handled with care.
+func SetClientDebugDump(w io.Writer) ClientOpt {
+	return func(c *Client) {
+		c.dump = &wireDumper{w: w}
+	}
+}
+
+// wireDumper serializes dumps so concurrent requests do not interleave.
+type wireDumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (d *wireDumper) dumpRequest(req *http.Request) {
+	redactedReq := req.Clone(req.Context())
+	for _, key := range redactedHeaders {
+		if redactedReq.Header.Get(key) != "" {
+			redactedReq.Header.Set(key, redacted)
+		}
+	}
+	// Only the head is dumped here, the body is read from a copy below so the request itself can still be sent.
+	head, err := httputil.DumpRequestOut(redactedReq, false)
+	if err != nil {
+		d.write("request dump failed: %v\n\n", err)
+		return
+	}
+
+	var body []byte
+	if req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			var r io.Reader = rc
+			if req.Header.Get("Content-Encoding") == "gzip" {
+				if zr, err := gzip.NewReader(rc); err == nil {
+					r = zr
+				}
+			}
+			body, _ = io.ReadAll(r)
+			rc.Close()
+		}
+	}
+	d.write(">>> %s%s\n\n", head, redactBody(body))
+}
+
+func (d *wireDumper) dumpResponse(res *http.Response, err error) {
+	if err != nil {
+		d.write("<<< %v\n\n", err)
+		return
+	}
+	dump, dumpErr := httputil.DumpResponse(res, true)
+	if dumpErr != nil {
+		d.write("response dump failed: %v\n\n", dumpErr)
+		return
+	}
+	head, body, _ := bytes.Cut(dump, []byte("\r\n\r\n"))
+	for _, key := range redactedHeaders {
+		if value := res.Header.Get(key); value != "" {
+			head = bytes.ReplaceAll(head, []byte(value), []byte(redacted))
+		}
+	}
+	d.write("<<< %s\r\n\r\n%s\n\n", head, redactBody(body))
+}
+
+func (d *wireDumper) write(format string, args ...interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fmt.Fprintf(d.w, format, args...)
+}
+
+func redactBody(body []byte) []byte {
+	body = secretJSONPattern.ReplaceAll(body, []byte(`${1}"`+redacted+`"`))
+	return secretFormPattern.ReplaceAll(body, []byte("${1}"+redacted))
+}
@@ -0,0 +1,108 @@
+package outlook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatISODuration formats d as an ISO 8601 duration (e.g. PT1H30M), the format microsoft uses for meeting durations.
+func FormatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteString("-")
+		d = -d
+	}
+	b.WriteString("P")
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if d == 0 {
+		return b.String()
+	}
+
+	b.WriteString("T")
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	if d > 0 {
+		seconds := strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+		fmt.Fprintf(&b, "%sS", seconds)
+	}
+	return b.String()
+}
+
+// ParseISODuration parses an ISO 8601 duration such as P1DT2H or PT45M. Years and months are rejected since they have no fixed length.
+func ParseISODuration(s string) (time.Duration, error) {
+	raw := s
+	negative := false
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 2 {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", raw)
+	}
+	s = s[1:]
+
+	var total time.Duration
+	inTime := false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", raw)
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		end := strings.IndexAny(s, "WDHMS")
+		if end <= 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", raw)
+		}
+		value, err := strconv.ParseFloat(strings.Replace(s[:end], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", raw, err)
+		}
+
+		var unit time.Duration
+		switch designator := s[end]; {
+		case designator == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case designator == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case designator == 'H' && inTime:
+			unit = time.Hour
+		case designator == 'M' && inTime:
+			unit = time.Minute
+		case designator == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("unsupported ISO 8601 duration component %q in %q", s[:end+1], raw)
+		}
+
+		total += time.Duration(value * float64(unit))
+		s = s[end+1:]
+	}
+
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
@@ -1,26 +1,257 @@
 package outlook
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
 var (
 	// ErrNoAccessToken is returned when a query is executed in a session which was either not given a refreshToken or that failed to retrieve and the access token.
 	ErrNoAccessToken = fmt.Errorf("no access token for session")
+
+	// ErrNoMeetingTimes is returned when no time in the requested window suits every attendee.
+	ErrNoMeetingTimes = fmt.Errorf("no meeting time available in the requested window")
+
+	// ErrSendAsDenied is returned when sending a message whose from address the caller lacks Send As or Send on Behalf rights for.
+	ErrSendAsDenied = fmt.Errorf("not permitted to send as or on behalf of the requested mailbox")
+
+	// ErrUnknownAlias is returned when sending from an address which is not one of the mailbox's proxy addresses.
+	ErrUnknownAlias = fmt.Errorf("address is not an alias of the mailbox")
+
+	// ErrBetaOnly is returned without calling graph for calls using features only graph's beta api supports on a session which
+	// does not use it, see Session.WithAPIVersion.
+	ErrBetaOnly = fmt.Errorf("only supported by graph's beta api")
+
+	// ErrPreconditionFailed is matched, through errors.Is, by the GraphError returned when a conditional update or delete fails because the resource's etag changed.
+	ErrPreconditionFailed = fmt.Errorf("resource was modified since it was read")
+
+	// ErrCircuitOpen is returned without calling graph while the client's circuit breaker is open after repeated failures.
+	ErrCircuitOpen = fmt.Errorf("circuit breaker open: graph api is failing")
 )
 
-// ErrStatusCode an error thrown when a given http call responds with a bad http status
-type ErrStatusCode struct {
-	Code                   int
+// GraphError an error thrown when a given http call responds with a bad http status, carrying the OData error microsoft returned.
+// RequestID, ClientRequestID, and Date are the correlation details microsoft support asks for when investigating a failed call.
+type GraphError struct {
+	StatusCode             int
+	Code                   string
 	Message                string
+	Target                 string
+	Details                []*GraphErrorDetail
+	InnerError             *GraphInnerError
 	SuggestedRetryDuration time.Duration
+	Throttle               *ThrottleInfo
+	RequestID              string
+	ClientRequestID        string
+	Date                   string
+	// Body the raw response body, kept for responses which are not OData errors.
+	Body string
+}
+
+// ThrottleInfo the throttling hints microsoft attaches to a throttled (or nearly throttled) response through the x-ms-throttle-* headers.
+type ThrottleInfo struct {
+	// RetryAfter the parsed Retry-After header, zero if it was absent.
+	RetryAfter time.Duration
+	// Scope the scope the limit applies to, e.g. "Tenant_Application/ReadWrite/<tenant>/<app>" (x-ms-throttle-scope).
+	Scope string
+	// Information the reason for throttling, e.g. "ResourceUnitLimitExceeded" (x-ms-throttle-information).
+	Information string
+	// LimitPercentage how close the caller is to the limit, 0 when absent (x-ms-throttle-limit-percentage).
+	LimitPercentage float64
+	// ResourceUnit the resource units the request cost (x-ms-resource-unit).
+	ResourceUnit int
+}
+
+// Category returns the limit category encoded in the throttle scope (e.g. "Tenant_Application"), or "" if unknown.
+func (ti *ThrottleInfo) Category() string {
+	category, _, _ := strings.Cut(ti.Scope, "/")
+	return category
+}
+
+func parseThrottleInfo(header http.Header, now time.Time) *ThrottleInfo {
+	ti := &ThrottleInfo{
+		RetryAfter:  parseRetryAfter(header.Get("Retry-After"), now),
+		Scope:       header.Get("x-ms-throttle-scope"),
+		Information: header.Get("x-ms-throttle-information"),
+	}
+	ti.LimitPercentage, _ = strconv.ParseFloat(header.Get("x-ms-throttle-limit-percentage"), 64)
+	ti.ResourceUnit, _ = strconv.Atoi(header.Get("x-ms-resource-unit"))
+	if *ti == (ThrottleInfo{}) {
+		return nil
+	}
+	return ti
 }
 
-func (sce *ErrStatusCode) Error() string {
+// parseRetryAfter parses a Retry-After header given either in seconds or as an http date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// GraphErrorDetail an additional error reported alongside the main OData error.
+type GraphErrorDetail struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Target  string `json:"target,omitempty"`
+}
+
+// GraphInnerError the service specific details of an OData error.
+type GraphInnerError struct {
+	Code            string           `json:"code,omitempty"`
+	Message         string           `json:"message,omitempty"`
+	Date            string           `json:"date,omitempty"`
+	RequestID       string           `json:"request-id,omitempty"`
+	ClientRequestID string           `json:"client-request-id,omitempty"`
+	InnerError      *GraphInnerError `json:"innerError,omitempty"`
+}
+
+type graphErrorResponse struct {
+	Error *struct {
+		Code       string              `json:"code"`
+		Message    string              `json:"message"`
+		Target     string              `json:"target"`
+		Details    []*GraphErrorDetail `json:"details"`
+		InnerError *GraphInnerError    `json:"innerError"`
+	} `json:"error"`
+}
+
+func (ge *GraphError) Error() string {
+	message := ge.Message
+	if ge.Code != "" {
+		message = fmt.Sprintf("%s: %s", ge.Code, ge.Message)
+	}
+	if ge.Throttle != nil && ge.Throttle.Scope != "" {
+		message = fmt.Sprintf("%s (throttle scope: %s, retry after: %s)", message, ge.Throttle.Scope, ge.SuggestedRetryDuration)
+	}
 	return fmt.Sprintf(
-		"Call to microsoft's graph api failed with a status code: %d. Reason: %s",
-		sce.Code,
-		sce.Message,
+		"Call to microsoft's graph api failed with a status code: %d. Reason: %s (request-id: %s, client-request-id: %s, date: %s)",
+		ge.StatusCode,
+		message,
+		ge.RequestID,
+		ge.ClientRequestID,
+		ge.Date,
 	)
 }
+
+// Is allows errors.Is(err, ErrPreconditionFailed) to match a failed If-Match request.
+func (ge *GraphError) Is(target error) bool {
+	return target == ErrPreconditionFailed && ge.StatusCode == http.StatusPreconditionFailed
+}
+
+// parseBody fills the error's OData fields from the response body, falling back to the raw body as the message when it is not an OData error.
+func (ge *GraphError) parseBody(data []byte) {
+	ge.Body = string(data)
+
+	var response graphErrorResponse
+	if err := json.Unmarshal(data, &response); err != nil || response.Error == nil {
+		ge.Message = ge.Body
+		return
+	}
+
+	ge.Code = response.Error.Code
+	ge.Message = response.Error.Message
+	ge.Target = response.Error.Target
+	ge.Details = response.Error.Details
+	ge.InnerError = response.Error.InnerError
+	if inner := ge.InnerError; inner != nil {
+		if ge.RequestID == "" {
+			ge.RequestID = inner.RequestID
+		}
+		if ge.ClientRequestID == "" {
+			ge.ClientRequestID = inner.ClientRequestID
+		}
+		if ge.Date == "" {
+			ge.Date = inner.Date
+		}
+	}
+}
+
+func isSendAsDenied(err error) bool {
+	graphErr := asGraphError(err)
+	if graphErr == nil || graphErr.StatusCode != http.StatusForbidden {
+		return false
+	}
+	return graphErr.Code == "ErrorSendAsDenied" || graphErr.Code == "ErrorSendOnBehalfOfDenied"
+}
+
+// asGraphError returns the GraphError in err's chain, or nil if there is none.
+func asGraphError(err error) *GraphError {
+	var graphErr *GraphError
+	if errors.As(err, &graphErr) {
+		return graphErr
+	}
+	return nil
+}
+
+// IsNotFound reports whether err is a graph api error with a 404 status, whatever its OData code.
+func IsNotFound(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && graphErr.StatusCode == http.StatusNotFound
+}
+
+// IsItemNotFound reports whether err reports a missing mailbox item (ErrorItemNotFound), as opposed to a missing resource
+// such as an unknown segment or user (ResourceNotFound) which usually points at a bad request rather than a deleted item.
+func IsItemNotFound(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && graphErr.Code == "ErrorItemNotFound"
+}
+
+// IsResourceNotFound reports whether err reports that the requested resource itself does not exist (ResourceNotFound).
+func IsResourceNotFound(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && graphErr.Code == "ResourceNotFound"
+}
+
+// IsThrottled reports whether err is a graph api error with a 429 status. SuggestedRetryDuration holds how long to back off for,
+// and Throttle the scope and reason microsoft gave.
+func IsThrottled(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && graphErr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsUnauthorized reports whether err is a graph api error with a 401 status, usually an expired or revoked token.
+func IsUnauthorized(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && graphErr.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is a graph api error with a 403 status, usually a missing permission or consent.
+func IsForbidden(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && graphErr.StatusCode == http.StatusForbidden
+}
+
+// IsConflict reports whether err is a graph api error with a 409 status, or a 412 status raised by a stale change key.
+func IsConflict(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && (graphErr.StatusCode == http.StatusConflict || graphErr.StatusCode == http.StatusPreconditionFailed)
+}
+
+// IsMailboxNotEnabled reports whether err reports that the user has no exchange online mailbox, e.g. an unlicensed
+// or on-premises user (MailboxNotEnabledForRESTAPI).
+func IsMailboxNotEnabled(err error) bool {
+	graphErr := asGraphError(err)
+	return graphErr != nil && (graphErr.Code == "MailboxNotEnabledForRESTAPI" || graphErr.Code == "MailboxNotSupportedForRESTAPI")
+}
+
+// IsSyncStateInvalid reports whether err reports that a delta link is no longer usable, because its sync state expired or was
+// reset (a 410 status, or SyncStateNotFound/SyncStateInvalid), meaning the delta query has to be restarted without it.
+func IsSyncStateInvalid(err error) bool {
+	graphErr := asGraphError(err)
+	if graphErr == nil {
+		return false
+	}
+	return graphErr.StatusCode == http.StatusGone || graphErr.Code == "SyncStateNotFound" || graphErr.Code == "SyncStateInvalid" || graphErr.Code == "resyncRequired"
+}
@@ -3,6 +3,8 @@ package outlook
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -17,11 +19,20 @@ var (
 		"lastModifiedDateTime",
 		"iCalUId",
 		"subject",
+		"body",
+		"bodyPreview",
+		"importance",
+		"sensitivity",
+		"isReminderOn",
+		"reminderMinutesBeforeStart",
 		"isAllDay",
 		"isCancelled",
 		"isOrganizer",
 		"showAs",
 		"onlineMeetingUrl",
+		"isOnlineMeeting",
+		"onlineMeetingProvider",
+		"onlineMeeting",
 		"recurrence",
 		"responseStatus",
 		"location",
@@ -54,6 +65,7 @@ type EventListCall struct {
 	maxResults int64
 	startTime  time.Time
 	endTime    time.Time
+	filters    []string
 }
 
 // List returns a EventListCall struct
@@ -89,15 +101,50 @@ func (elc *EventListCall) EndTime(end time.Time) *EventListCall {
 	return elc
 }
 
+// Importance limits the event list call to events of the given importance.
+func (elc *EventListCall) Importance(importance Importance) *EventListCall {
+	elc.filters = append(elc.filters, importance.Filter())
+	return elc
+}
+
+// Sensitivity limits the event list call to events of the given sensitivity.
+func (elc *EventListCall) Sensitivity(sensitivity Sensitivity) *EventListCall {
+	elc.filters = append(elc.filters, sensitivity.Filter())
+	return elc
+}
+
 // Do executes the event list call, returning the event list result.
-func (elc *EventListCall) Do(ctx context.Context) (*EventListResult, error) {
+func (elc *EventListCall) Do(ctx context.Context, opts ...RequestOption) (*EventListResult, error) {
+	var result EventListResult
+	if _, err := elc.do(ctx, &result, opts); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DoResponse executes the event list call, returning the page along with its paging and correlation metadata.
+func (elc *EventListCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Event], error) {
+	var page odataCollection[*Event]
+	res, err := elc.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (elc *EventListCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
 	params := map[string]interface{}{
 		"$top":          elc.maxResults,
 		"$count":        true,
-		"startDateTime": elc.startTime.Format(DefaultQueryDateTimeFormat),
-		"endDateTime":   elc.endTime.Format(DefaultQueryDateTimeFormat),
+		"startDateTime": elc.startTime.UTC().Format(DefaultQueryDateTimeFormat),
+		"endDateTime":   elc.endTime.UTC().Format(DefaultQueryDateTimeFormat),
 		"$select":       DefaultEventFields,
 	}
+	if len(elc.filters) > 0 {
+		params["$filter"] = strings.Join(elc.filters, " and ")
+	}
 	if elc.nextLink != "" {
 		params["$skip"] = parsePageLink(elc.nextLink, "$skip")
 	}
@@ -109,12 +156,7 @@ func (elc *EventListCall) Do(ctx context.Context) (*EventListResult, error) {
 		path = fmt.Sprintf("/calendars/%s%s", elc.calendarID, "/calendarView")
 	}
 
-	var result EventListResult
-	if _, err := elc.service.session.Get(ctx, path, params, &result); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return elc.service.session.Get(ctx, path, params, result, opts...)
 }
 
 // EventGetCall struct allowing for fluent style configuration of calls to the event get endpoint.
@@ -134,7 +176,7 @@ func (es *EventService) Get(calendarID string, eventID string) *EventGetCall {
 }
 
 // Do executes the http get to microsoft's graph api to get the call's event.
-func (egc *EventGetCall) Do(ctx context.Context) (*Event, error) {
+func (egc *EventGetCall) Do(ctx context.Context, opts ...RequestOption) (*Event, error) {
 	var path string
 	if egc.calendarID == "primary" {
 		path = fmt.Sprintf("/events/%s", egc.eventID)
@@ -142,7 +184,7 @@ func (egc *EventGetCall) Do(ctx context.Context) (*Event, error) {
 		path = fmt.Sprintf("/calendars/%s%s/%s", egc.calendarID, egc.service.basePath, egc.eventID)
 	}
 	event := Event{}
-	if _, err := egc.service.session.Get(ctx, path, nil, &event); err != nil {
+	if _, err := egc.service.session.Get(ctx, path, nil, &event, opts...); err != nil {
 		return nil, err
 	}
 	return &event, nil
@@ -171,9 +213,14 @@ func (ecc *EventCreateCall) Event(event *Event) *EventCreateCall {
 }
 
 // Do executes the http post to microsoft's graph api to create the call's event.
-func (ecc *EventCreateCall) Do(ctx context.Context) (*Event, error) {
-	path := fmt.Sprintf("/calendars/%s%s", ecc.calendarID, ecc.service.basePath)
-	if _, err := ecc.service.session.Post(ctx, path, ecc.event, ecc.event); err != nil {
+func (ecc *EventCreateCall) Do(ctx context.Context, opts ...RequestOption) (*Event, error) {
+	var path string
+	if ecc.calendarID == "primary" {
+		path = ecc.service.basePath
+	} else {
+		path = fmt.Sprintf("/calendars/%s%s", ecc.calendarID, ecc.service.basePath)
+	}
+	if _, err := ecc.service.session.Post(ctx, path, ecc.event, ecc.event, opts...); err != nil {
 		return nil, err
 	}
 	return ecc.event, nil
@@ -184,6 +231,7 @@ type EventUpdateCall struct {
 	service    *EventService
 	calendarID string
 	event      *Event
+	ifMatch    string
 }
 
 // Update returns an instance of an EventUpdateCall with the given calendarID.
@@ -201,15 +249,24 @@ func (euc *EventUpdateCall) Event(event *Event) *EventUpdateCall {
 	return euc
 }
 
+// IfMatch makes the update conditional on the event's etag still being etag, failing with an error matching ErrPreconditionFailed otherwise.
+func (euc *EventUpdateCall) IfMatch(etag string) *EventUpdateCall {
+	euc.ifMatch = etag
+	return euc
+}
+
 // Do executes the http patch to microsoft's graph api to update the call's event.
-func (euc *EventUpdateCall) Do(ctx context.Context) (*Event, error) {
+func (euc *EventUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*Event, error) {
+	if euc.ifMatch != "" {
+		opts = append([]RequestOption{SetRequestIfMatch(euc.ifMatch)}, opts...)
+	}
 	var path string
 	if euc.calendarID == "primary" {
 		path = fmt.Sprintf("/events/%s", euc.event.ID)
 	} else {
 		path = fmt.Sprintf("/calendars/%s%s/%s", euc.calendarID, euc.service.basePath, euc.event.ID)
 	}
-	if _, err := euc.service.session.Patch(ctx, path, euc.event, euc.event); err != nil {
+	if _, err := euc.service.session.Patch(ctx, path, euc.event, euc.event, opts...); err != nil {
 		return nil, err
 	}
 	return euc.event, nil
@@ -220,9 +277,11 @@ type EventDeleteCall struct {
 	service    *EventService
 	calendarID string
 	eventID    string
+	ifMatch    string
 }
 
 // Delete returns an instance of an EventDeleteCall with the given calendarID and eventID.
+// Deleting removes the event from the user's calendar without notifying attendees; organizers should use Cancel instead.
 func (es *EventService) Delete(calendarID, eventID string) *EventDeleteCall {
 	return &EventDeleteCall{
 		service:    es,
@@ -231,15 +290,517 @@ func (es *EventService) Delete(calendarID, eventID string) *EventDeleteCall {
 	}
 }
 
+// IfMatch makes the delete conditional on the event's etag still being etag, failing with an error matching ErrPreconditionFailed otherwise.
+func (edc *EventDeleteCall) IfMatch(etag string) *EventDeleteCall {
+	edc.ifMatch = etag
+	return edc
+}
+
 // Do executes the http delete to microsoft's graph api to delete the call's event.
-func (edc *EventDeleteCall) Do(ctx context.Context) error {
+func (edc *EventDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	if edc.ifMatch != "" {
+		opts = append([]RequestOption{SetRequestIfMatch(edc.ifMatch)}, opts...)
+	}
 	var path string
 	if edc.calendarID == "primary" {
 		path = fmt.Sprintf("/events/%s", edc.eventID)
 	} else {
 		path = fmt.Sprintf("/calendars/%s%s/%s", edc.calendarID, edc.service.basePath, edc.eventID)
 	}
-	if _, err := edc.service.session.Delete(ctx, path, nil, nil); err != nil {
+	if _, err := edc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EventInstancesCall struct allowing for fluent style configuration of calls to the event instances endpoint.
+type EventInstancesCall struct {
+	service        *EventService
+	seriesMasterID string
+	nextLink       string
+	maxResults     int64
+	startTime      time.Time
+	endTime        time.Time
+}
+
+// Instances returns an instance of an EventInstancesCall listing the occurrences and exceptions of the given series master between start and end.
+func (es *EventService) Instances(seriesMasterID string, start, end time.Time) *EventInstancesCall {
+	return &EventInstancesCall{
+		service:        es,
+		seriesMasterID: seriesMasterID,
+		maxResults:     10,
+		startTime:      start,
+		endTime:        end,
+	}
+}
+
+// MaxResults sets the $top query parameter for the event instances call.
+func (eic *EventInstancesCall) MaxResults(pageSize int64) *EventInstancesCall {
+	eic.maxResults = pageSize
+	return eic
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the event instances call.
+func (eic *EventInstancesCall) NextLink(link string) *EventInstancesCall {
+	eic.nextLink = link
+	return eic
+}
+
+// Do executes the event instances call, returning the event list result.
+func (eic *EventInstancesCall) Do(ctx context.Context, opts ...RequestOption) (*EventListResult, error) {
+	var result EventListResult
+	if _, err := eic.do(ctx, &result, opts); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DoResponse executes the event instances call, returning the page along with its paging and correlation metadata.
+func (eic *EventInstancesCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Event], error) {
+	var page odataCollection[*Event]
+	res, err := eic.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (eic *EventInstancesCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
+	params := map[string]interface{}{
+		"$top":          eic.maxResults,
+		"startDateTime": eic.startTime.UTC().Format(DefaultQueryDateTimeFormat),
+		"endDateTime":   eic.endTime.UTC().Format(DefaultQueryDateTimeFormat),
+		"$select":       DefaultEventFields,
+	}
+	if eic.nextLink != "" {
+		params["$skip"] = parsePageLink(eic.nextLink, "$skip")
+	}
+
+	path := fmt.Sprintf("%s/%s/instances", eic.service.basePath, eic.seriesMasterID)
+
+	return eic.service.session.Get(ctx, path, params, result, opts...)
+}
+
+// EventCalendarViewCall struct allowing for fluent style configuration of calls to the calendarView endpoint.
+// Recurring events are expanded server-side into their individual occurrences within the requested window.
+type EventCalendarViewCall struct {
+	service    *EventService
+	calendarID string
+	timeZone   string
+	nextLink   string
+	maxResults int64
+	startTime  time.Time
+	endTime    time.Time
+}
+
+// CalendarView returns an instance of an EventCalendarViewCall for the events occurring between start and end in the primary calendar.
+func (es *EventService) CalendarView(start, end time.Time) *EventCalendarViewCall {
+	return &EventCalendarViewCall{
+		service:    es,
+		calendarID: "primary",
+		maxResults: 10,
+		startTime:  start,
+		endTime:    end,
+	}
+}
+
+// CalendarID sets the calendar the view is read from. Defaults to the primary calendar.
+func (ecvc *EventCalendarViewCall) CalendarID(calendarID string) *EventCalendarViewCall {
+	ecvc.calendarID = calendarID
+	return ecvc
+}
+
+// TimeZone sets the outlook.timezone preference, causing start and end times to be returned in the given time zone rather than UTC.
+func (ecvc *EventCalendarViewCall) TimeZone(tz string) *EventCalendarViewCall {
+	ecvc.timeZone = tz
+	return ecvc
+}
+
+// MaxResults sets the $top query parameter for the calendar view call.
+func (ecvc *EventCalendarViewCall) MaxResults(pageSize int64) *EventCalendarViewCall {
+	ecvc.maxResults = pageSize
+	return ecvc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the calendar view call.
+func (ecvc *EventCalendarViewCall) NextLink(link string) *EventCalendarViewCall {
+	ecvc.nextLink = link
+	return ecvc
+}
+
+// Do executes the calendar view call, returning the event list result.
+func (ecvc *EventCalendarViewCall) Do(ctx context.Context, opts ...RequestOption) (*EventListResult, error) {
+	var result EventListResult
+	if _, err := ecvc.do(ctx, &result, opts); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DoResponse executes the calendar view call, returning the page along with its paging and correlation metadata.
+func (ecvc *EventCalendarViewCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Event], error) {
+	var page odataCollection[*Event]
+	res, err := ecvc.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (ecvc *EventCalendarViewCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
+	params := map[string]interface{}{
+		"$top":          ecvc.maxResults,
+		"startDateTime": ecvc.startTime.UTC().Format(DefaultQueryDateTimeFormat),
+		"endDateTime":   ecvc.endTime.UTC().Format(DefaultQueryDateTimeFormat),
+		"$select":       DefaultEventFields,
+		"$orderby":      "start/dateTime",
+	}
+	if ecvc.nextLink != "" {
+		params["$skip"] = parsePageLink(ecvc.nextLink, "$skip")
+	}
+
+	header := http.Header{}
+	if ecvc.timeZone != "" {
+		header.Set("Prefer", fmt.Sprintf("outlook.timezone=%q", ecvc.timeZone))
+	}
+
+	var path string
+	if ecvc.calendarID == "primary" {
+		path = "/calendarView"
+	} else {
+		path = fmt.Sprintf("/calendars/%s/calendarView", ecvc.calendarID)
+	}
+
+	return ecvc.service.session.query(ctx, http.MethodGet, path, params, header, nil, result, opts...)
+}
+
+// EventDeltaCall struct allowing for fluent style configuration of delta queries on the calendarView endpoint.
+type EventDeltaCall struct {
+	service    *EventService
+	calendarID string
+	link       string
+	maxResults int64
+	startTime  time.Time
+	endTime    time.Time
+}
+
+// CalendarViewDelta returns an instance of an EventDeltaCall for the changes to the events occurring between start and end
+// in the primary calendar. The first round of pages returns every event in the window, and the DeltaLink on its last page
+// resumes from there, returning only the events created, updated, or removed since. Removed events carry Removed.
+func (es *EventService) CalendarViewDelta(start, end time.Time) *EventDeltaCall {
+	return &EventDeltaCall{
+		service:    es,
+		calendarID: "primary",
+		startTime:  start,
+		endTime:    end,
+	}
+}
+
+// CalendarID sets the calendar the delta is read from. Defaults to the primary calendar.
+func (edc *EventDeltaCall) CalendarID(calendarID string) *EventDeltaCall {
+	edc.calendarID = calendarID
+	return edc
+}
+
+// MaxResults sets the odata.maxpagesize preference for the delta call.
+func (edc *EventDeltaCall) MaxResults(pageSize int64) *EventDeltaCall {
+	edc.maxResults = pageSize
+	return edc
+}
+
+// NextLink uses the link provided, either a NextLink or a DeltaLink of a previous response, to continue the delta query.
+// The link's state tokens are carried over, so its window takes precedence over the one the call was created with.
+func (edc *EventDeltaCall) NextLink(link string) *EventDeltaCall {
+	edc.link = link
+	return edc
+}
+
+// DeltaLink is an alias of NextLink, resuming the delta query from the DeltaLink of a previous round.
+func (edc *EventDeltaCall) DeltaLink(link string) *EventDeltaCall {
+	return edc.NextLink(link)
+}
+
+// Do executes the delta call, returning the page along with the link to the next page or, on the last page, the delta link.
+func (edc *EventDeltaCall) Do(ctx context.Context, opts ...RequestOption) (*Response[*Event], error) {
+	params := map[string]interface{}{
+		"startDateTime": edc.startTime.UTC().Format(DefaultQueryDateTimeFormat),
+		"endDateTime":   edc.endTime.UTC().Format(DefaultQueryDateTimeFormat),
+	}
+	if edc.link != "" {
+		if parsed, err := url.Parse(edc.link); err == nil {
+			params = map[string]interface{}{}
+			for key, values := range parsed.Query() {
+				params[key] = values[0]
+			}
+		}
+	}
+
+	header := http.Header{}
+	if edc.maxResults > 0 {
+		header.Set("Prefer", fmt.Sprintf("odata.maxpagesize=%d", edc.maxResults))
+	}
+
+	var path string
+	if edc.calendarID == "primary" {
+		path = "/calendarView/delta"
+	} else {
+		path = fmt.Sprintf("/calendars/%s/calendarView/delta", edc.calendarID)
+	}
+
+	var page odataCollection[*Event]
+	res, err := edc.service.session.query(ctx, http.MethodGet, path, params, header, nil, &page, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if page.NextLink == "" && page.DeltaLink == "" {
+		return nil, ErrNoDeltaLink
+	}
+
+	return newResponse(&page, res), nil
+}
+
+// Event response actions available to invitees.
+const (
+	EventResponseAccept            = "accept"
+	EventResponseDecline           = "decline"
+	EventResponseTentativelyAccept = "tentativelyAccept"
+)
+
+type eventResponseRequest struct {
+	Comment         string    `json:"comment,omitempty"`
+	SendResponse    bool      `json:"sendResponse"`
+	ProposedNewTime *TimeSlot `json:"proposedNewTime,omitempty"`
+}
+
+// EventRespondCall struct allowing for fluent style configuration of calls to the event accept, decline, and tentativelyAccept endpoints.
+type EventRespondCall struct {
+	service         *EventService
+	eventID         string
+	messageID       string
+	action          string
+	comment         string
+	sendResponse    bool
+	proposedNewTime *TimeSlot
+}
+
+func (es *EventService) respond(eventID, action string) *EventRespondCall {
+	return &EventRespondCall{
+		service:      es,
+		eventID:      eventID,
+		action:       action,
+		sendResponse: true,
+	}
+}
+
+// Accept returns an instance of an EventRespondCall which accepts the given event.
+func (es *EventService) Accept(eventID string) *EventRespondCall {
+	return es.respond(eventID, EventResponseAccept)
+}
+
+// Decline returns an instance of an EventRespondCall which declines the given event.
+func (es *EventService) Decline(eventID string) *EventRespondCall {
+	return es.respond(eventID, EventResponseDecline)
+}
+
+// TentativelyAccept returns an instance of an EventRespondCall which tentatively accepts the given event.
+func (es *EventService) TentativelyAccept(eventID string) *EventRespondCall {
+	return es.respond(eventID, EventResponseTentativelyAccept)
+}
+
+// Comment sets the text included in the response sent to the organizer.
+func (erc *EventRespondCall) Comment(comment string) *EventRespondCall {
+	erc.comment = comment
+	return erc
+}
+
+// SendResponse sets whether a response is sent to the organizer. Defaults to true.
+func (erc *EventRespondCall) SendResponse(send bool) *EventRespondCall {
+	erc.sendResponse = send
+	return erc
+}
+
+// ProposeNewTime sets an alternative time for the meeting to suggest to the organizer. Only valid when declining or tentatively accepting.
+func (erc *EventRespondCall) ProposeNewTime(start, end *DateTimeTimeZone) *EventRespondCall {
+	erc.proposedNewTime = &TimeSlot{Start: start, End: end}
+	return erc
+}
+
+// Do executes the http post to microsoft's graph api to respond to the call's event.
+func (erc *EventRespondCall) Do(ctx context.Context, opts ...RequestOption) error {
+	if erc.proposedNewTime != nil {
+		if erc.action == EventResponseAccept {
+			return fmt.Errorf("a new time can only be proposed when declining or tentatively accepting an event")
+		}
+		if !erc.sendResponse {
+			return fmt.Errorf("a new time can only be proposed when a response is sent to the organizer")
+		}
+	}
+
+	eventID := erc.eventID
+	if erc.messageID != "" {
+		// Responding from an invitation: the meeting is the one the invitation links to in the user's calendar.
+		message, err := NewMessageService(erc.service.session).GetEventMessage(erc.messageID).ExpandEvent().Do(ctx, opts...)
+		if err != nil {
+			return err
+		}
+		if message.Event == nil || message.Event.ID == "" {
+			return fmt.Errorf("message %s is not linked to an event", erc.messageID)
+		}
+		eventID = message.Event.ID
+	}
+
+	path := fmt.Sprintf("%s/%s/%s", erc.service.basePath, eventID, erc.action)
+	body := &eventResponseRequest{
+		Comment:         erc.comment,
+		SendResponse:    erc.sendResponse,
+		ProposedNewTime: erc.proposedNewTime,
+	}
+	if _, err := erc.service.session.Post(ctx, path, body, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+type eventCancelRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// EventCancelCall struct allowing for fluent style configuration of calls to the event cancel endpoint.
+type EventCancelCall struct {
+	service *EventService
+	eventID string
+	comment string
+}
+
+// Cancel returns an instance of an EventCancelCall with the given eventID. Only the organizer may cancel a meeting, and doing so sends a cancellation to all attendees.
+func (es *EventService) Cancel(eventID string) *EventCancelCall {
+	return &EventCancelCall{
+		service: es,
+		eventID: eventID,
+	}
+}
+
+// Comment sets the text included in the cancellation sent to attendees.
+func (ecc *EventCancelCall) Comment(comment string) *EventCancelCall {
+	ecc.comment = comment
+	return ecc
+}
+
+// Do executes the http post to microsoft's graph api to cancel the call's event.
+func (ecc *EventCancelCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s/cancel", ecc.service.basePath, ecc.eventID)
+	body := &eventCancelRequest{Comment: ecc.comment}
+	if _, err := ecc.service.session.Post(ctx, path, body, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+type eventForwardRequest struct {
+	ToRecipients []*Recipient `json:"ToRecipients"`
+	Comment      string       `json:"Comment,omitempty"`
+}
+
+// EventForwardCall struct allowing for fluent style configuration of calls to the event forward endpoint.
+type EventForwardCall struct {
+	service    *EventService
+	eventID    string
+	recipients []*Recipient
+	comment    string
+}
+
+// Forward returns an instance of an EventForwardCall which forwards the given event to additional recipients.
+func (es *EventService) Forward(eventID string, recipients ...*Recipient) *EventForwardCall {
+	return &EventForwardCall{
+		service:    es,
+		eventID:    eventID,
+		recipients: recipients,
+	}
+}
+
+// Recipients appends recipients to forward the event to.
+func (efc *EventForwardCall) Recipients(recipients ...*Recipient) *EventForwardCall {
+	efc.recipients = append(efc.recipients, recipients...)
+	return efc
+}
+
+// Comment sets the text included in the forwarded invitation.
+func (efc *EventForwardCall) Comment(comment string) *EventForwardCall {
+	efc.comment = comment
+	return efc
+}
+
+// Do executes the http post to microsoft's graph api to forward the call's event.
+func (efc *EventForwardCall) Do(ctx context.Context, opts ...RequestOption) error {
+	if len(efc.recipients) == 0 {
+		return fmt.Errorf("at least one recipient is required to forward an event")
+	}
+	path := fmt.Sprintf("%s/%s/forward", efc.service.basePath, efc.eventID)
+	body := &eventForwardRequest{
+		ToRecipients: efc.recipients,
+		Comment:      efc.comment,
+	}
+	if _, err := efc.service.session.Post(ctx, path, body, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+type eventSnoozeReminderRequest struct {
+	NewReminderTime *DateTimeTimeZone `json:"newReminderTime"`
+}
+
+// EventSnoozeReminderCall struct allowing for fluent style configuration of calls to the event snoozeReminder endpoint.
+type EventSnoozeReminderCall struct {
+	service         *EventService
+	eventID         string
+	newReminderTime *DateTimeTimeZone
+}
+
+// SnoozeReminder returns an instance of an EventSnoozeReminderCall which postpones the given event's reminder until newReminderTime.
+func (es *EventService) SnoozeReminder(eventID string, newReminderTime *DateTimeTimeZone) *EventSnoozeReminderCall {
+	return &EventSnoozeReminderCall{
+		service:         es,
+		eventID:         eventID,
+		newReminderTime: newReminderTime,
+	}
+}
+
+// Do executes the http post to microsoft's graph api to snooze the call's event reminder.
+func (esrc *EventSnoozeReminderCall) Do(ctx context.Context, opts ...RequestOption) error {
+	if esrc.newReminderTime == nil {
+		return fmt.Errorf("a new reminder time is required to snooze a reminder")
+	}
+	path := fmt.Sprintf("%s/%s/snoozeReminder", esrc.service.basePath, esrc.eventID)
+	body := &eventSnoozeReminderRequest{NewReminderTime: esrc.newReminderTime}
+	if _, err := esrc.service.session.Post(ctx, path, body, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EventDismissReminderCall struct allowing for fluent style configuration of calls to the event dismissReminder endpoint.
+type EventDismissReminderCall struct {
+	service *EventService
+	eventID string
+}
+
+// DismissReminder returns an instance of an EventDismissReminderCall which dismisses the given event's reminder.
+func (es *EventService) DismissReminder(eventID string) *EventDismissReminderCall {
+	return &EventDismissReminderCall{
+		service: es,
+		eventID: eventID,
+	}
+}
+
+// Do executes the http post to microsoft's graph api to dismiss the call's event reminder.
+func (edrc *EventDismissReminderCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s/dismissReminder", edrc.service.basePath, edrc.eventID)
+	if _, err := edrc.service.session.Post(ctx, path, nil, nil, opts...); err != nil {
 		return err
 	}
 	return nil
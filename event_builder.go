@@ -0,0 +1,181 @@
+package outlook
+
+import (
+	"fmt"
+	"time"
+)
+
+// EventBuilder struct allowing for fluent style composition of an Event for EventService.Create. Errors, such as
+// unparsable addresses or an invalid recurrence, are deferred until Build.
+type EventBuilder struct {
+	event      *Event
+	start, end time.Time
+	recurrence *RecurrenceBuilder
+	err        error
+}
+
+// NewEventBuilder returns a new EventBuilder for an empty event.
+func NewEventBuilder() *EventBuilder {
+	return &EventBuilder{event: &Event{}}
+}
+
+// Subject sets the event's subject.
+func (eb *EventBuilder) Subject(subject string) *EventBuilder {
+	eb.event.Subject = subject
+	return eb
+}
+
+// HTMLBody sets the event's body to the given html.
+func (eb *EventBuilder) HTMLBody(html string) *EventBuilder {
+	eb.event.Body = &MessageBody{ContentType: BodyContentTypeHTML, Content: html}
+	return eb
+}
+
+// TextBody sets the event's body to the given plain text.
+func (eb *EventBuilder) TextBody(text string) *EventBuilder {
+	eb.event.Body = &MessageBody{ContentType: BodyContentTypeText, Content: text}
+	return eb
+}
+
+// Start sets when the event starts. The time's location is kept as the event's time zone, except for time.Local which is sent as UTC.
+func (eb *EventBuilder) Start(start time.Time) *EventBuilder {
+	eb.start = start
+	return eb
+}
+
+// End sets when the event ends.
+func (eb *EventBuilder) End(end time.Time) *EventBuilder {
+	eb.end = end
+	return eb
+}
+
+// Duration sets the event's end relative to its start.
+func (eb *EventBuilder) Duration(d time.Duration) *EventBuilder {
+	eb.end = eb.start.Add(d)
+	return eb
+}
+
+// AllDay makes the event an all day event on the given day, ending at the following midnight. Only the day's date is kept,
+// as graph requires all day events to start and end at midnight in their time zone.
+func (eb *EventBuilder) AllDay(day time.Time) *EventBuilder {
+	eb.event.AllDay = true
+	eb.start = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	eb.end = eb.start.AddDate(0, 0, 1)
+	return eb
+}
+
+// Location sets the event's location to a display name such as a room or address.
+func (eb *EventBuilder) Location(displayName string) *EventBuilder {
+	eb.event.Location = &Location{DisplayName: displayName}
+	return eb
+}
+
+// Attendee adds attendees of the given type, AttendeeTypeRequired, AttendeeTypeOptional, or AttendeeTypeResource,
+// given either as bare addresses or in "Name <address>" form.
+func (eb *EventBuilder) Attendee(attendeeType string, addresses ...string) *EventBuilder {
+	for _, address := range addresses {
+		recipient, err := ParseRecipient(address)
+		if err != nil {
+			eb.setErr(fmt.Errorf("event: %w", err))
+			continue
+		}
+		eb.event.Attendees = append(eb.event.Attendees, &Attendee{
+			Type:         attendeeType,
+			EmailAddress: recipient.EmailAddress,
+		})
+	}
+	return eb
+}
+
+// Required adds required attendees.
+func (eb *EventBuilder) Required(addresses ...string) *EventBuilder {
+	return eb.Attendee(AttendeeTypeRequired, addresses...)
+}
+
+// Optional adds optional attendees.
+func (eb *EventBuilder) Optional(addresses ...string) *EventBuilder {
+	return eb.Attendee(AttendeeTypeOptional, addresses...)
+}
+
+// Resource adds resources, such as rooms or equipment, by their mailbox address.
+func (eb *EventBuilder) Resource(addresses ...string) *EventBuilder {
+	return eb.Attendee(AttendeeTypeResource, addresses...)
+}
+
+// Recurrence makes the event a recurring series, see NewRecurrence. The range's start date defaults to the event's start.
+func (eb *EventBuilder) Recurrence(recurrence *RecurrenceBuilder) *EventBuilder {
+	eb.recurrence = recurrence
+	return eb
+}
+
+// Reminder sets a reminder the given time before the event starts.
+func (eb *EventBuilder) Reminder(before time.Duration) *EventBuilder {
+	eb.event.ReminderOn = true
+	eb.event.ReminderMinutesBeforeStart = int(before / time.Minute)
+	return eb
+}
+
+// OnlineMeeting makes the event a teams meeting, with microsoft generating its join details.
+func (eb *EventBuilder) OnlineMeeting() *EventBuilder {
+	eb.event.IsOnlineMeeting = true
+	eb.event.OnlineMeetingProvider = OnlineMeetingProviderTeamsForBusiness
+	return eb
+}
+
+// Sensitivity sets the event's sensitivity, e.g. SensitivityPrivate.
+func (eb *EventBuilder) Sensitivity(sensitivity Sensitivity) *EventBuilder {
+	if !sensitivity.Valid() && eb.err == nil {
+		eb.err = fmt.Errorf("event: invalid sensitivity %q", sensitivity)
+	}
+	eb.event.Sensitivity = sensitivity
+	return eb
+}
+
+// ShowAs sets how the event shows in the organizer's free/busy, e.g. EventShowAsTentative.
+func (eb *EventBuilder) ShowAs(showAs string) *EventBuilder {
+	eb.event.ShowAs = showAs
+	return eb
+}
+
+// Build returns the composed event, or the first error encountered while composing it.
+func (eb *EventBuilder) Build() (*Event, error) {
+	if eb.err != nil {
+		return nil, eb.err
+	}
+	if eb.start.IsZero() || eb.end.IsZero() {
+		return nil, fmt.Errorf("event: start and end are required")
+	}
+	if !eb.end.After(eb.start) {
+		return nil, fmt.Errorf("event: end %s is not after start %s", eb.end, eb.start)
+	}
+
+	event := *eb.event
+	if event.AllDay {
+		event.Start = newAllDayDateTimeTimeZone(eb.start)
+		event.End = newAllDayDateTimeTimeZone(eb.end)
+	} else {
+		event.Start = NewDateTimeTimeZone(eb.start)
+		event.End = NewDateTimeTimeZone(eb.end)
+	}
+	if eb.recurrence != nil {
+		// The recurrence is built only now, so its range can default to starting on the event's start date.
+		rb := *eb.recurrence
+		rng := *rb.rng
+		if rng.StartDate == "" {
+			rng.StartDate = eb.start.Format(RecurrenceDateFormat)
+		}
+		rb.rng = &rng
+		recurrence, err := rb.Build()
+		if err != nil {
+			return nil, err
+		}
+		event.Recurrence = recurrence
+	}
+	return &event, nil
+}
+
+func (eb *EventBuilder) setErr(err error) {
+	if eb.err == nil {
+		eb.err = err
+	}
+}
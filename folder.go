@@ -1,6 +1,10 @@
 package outlook
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
 
 // FolderService manages communication with microsofts graph for folder resources.
 type FolderService struct {
@@ -18,9 +22,10 @@ func NewFolderService(session *Session) *FolderService {
 
 // FolderListCall struct allowing for fluent style configuration of calls to the mailFolder list endpoint.
 type FolderListCall struct {
-	service    *FolderService
-	nextLink   string
-	maxResults int64
+	service       *FolderService
+	nextLink      string
+	maxResults    int64
+	includeHidden bool
 }
 
 // List returns a FolderListCall builder struct
@@ -43,20 +48,281 @@ func (flc *FolderListCall) NextLink(link string) *FolderListCall {
 	return flc
 }
 
+// IncludeHidden sets the includeHiddenFolders query parameter, so hidden folders are listed too.
+func (flc *FolderListCall) IncludeHidden(include bool) *FolderListCall {
+	flc.includeHidden = include
+	return flc
+}
+
 // Do executes the folder list call, returning the folder list result.
-func (flc *FolderListCall) Do(ctx context.Context) (*FolderListResult, error) {
+func (flc *FolderListCall) Do(ctx context.Context, opts ...RequestOption) (*FolderListResult, error) {
+	var result FolderListResult
+	if _, err := flc.do(ctx, &result, opts); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DoResponse executes the folder list call, returning the page along with its paging and correlation metadata.
+func (flc *FolderListCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Folder], error) {
+	var page odataCollection[*Folder]
+	res, err := flc.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (flc *FolderListCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
 	params := map[string]interface{}{
 		"$top":   flc.maxResults,
 		"$count": true,
 	}
+	if flc.includeHidden {
+		params["includeHiddenFolders"] = true
+	}
 	if flc.nextLink != "" {
 		params["$skip"] = parsePageLink(flc.nextLink, "$skip")
 	}
 
+	return flc.service.session.Get(ctx, flc.service.basePath, params, result, opts...)
+}
+
+// FolderGetCall struct allowing for fluent style configuration of calls to the mailFolder get endpoint.
+type FolderGetCall struct {
+	service  *FolderService
+	folderID string
+}
+
+// Get returns an instance of a FolderGetCall with the given folderID, which may be a well-known folder name.
+func (fs *FolderService) Get(folderID string) *FolderGetCall {
+	return &FolderGetCall{
+		service:  fs,
+		folderID: folderID,
+	}
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's folder.
+func (fgc *FolderGetCall) Do(ctx context.Context, opts ...RequestOption) (*Folder, error) {
+	path := fmt.Sprintf("%s/%s", fgc.service.basePath, fgc.folderID)
+	folder := Folder{}
+	if _, err := fgc.service.session.Get(ctx, path, nil, &folder, opts...); err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// FolderCreateCall struct allowing for fluent style configuration of calls to the mailFolder create endpoint.
+type FolderCreateCall struct {
+	service  *FolderService
+	parentID string
+	folder   *Folder
+}
+
+// Create returns an instance of a FolderCreateCall creating a top level folder with the given display name.
+func (fs *FolderService) Create(displayName string) *FolderCreateCall {
+	return &FolderCreateCall{
+		service: fs,
+		folder:  &Folder{DisplayName: displayName},
+	}
+}
+
+// Parent creates the folder as a child of the given folder instead of at the top level.
+func (fcc *FolderCreateCall) Parent(parentID string) *FolderCreateCall {
+	fcc.parentID = parentID
+	return fcc
+}
+
+// Hidden creates the folder hidden from Outlook's folder list. A folder can not be hidden or unhidden once created.
+func (fcc *FolderCreateCall) Hidden(hidden bool) *FolderCreateCall {
+	fcc.folder.IsHidden = hidden
+	return fcc
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's folder.
+func (fcc *FolderCreateCall) Do(ctx context.Context, opts ...RequestOption) (*Folder, error) {
+	path := fcc.service.basePath
+	if fcc.parentID != "" {
+		path = fmt.Sprintf("%s/%s/childFolders", fcc.service.basePath, fcc.parentID)
+	}
+	if _, err := fcc.service.session.Post(ctx, path, fcc.folder, fcc.folder, opts...); err != nil {
+		return nil, err
+	}
+	return fcc.folder, nil
+}
+
+// FolderUpdateCall struct allowing for fluent style configuration of calls to the mailFolder update endpoint.
+type FolderUpdateCall struct {
+	service     *FolderService
+	folderID    string
+	displayName string
+}
+
+// Update returns an instance of a FolderUpdateCall renaming the given folder. Only the display name may be updated.
+func (fs *FolderService) Update(folderID, displayName string) *FolderUpdateCall {
+	return &FolderUpdateCall{
+		service:     fs,
+		folderID:    folderID,
+		displayName: displayName,
+	}
+}
+
+// Do executes the http patch request to microsoft's graph api to update the call's folder.
+func (fuc *FolderUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*Folder, error) {
+	path := fmt.Sprintf("%s/%s", fuc.service.basePath, fuc.folderID)
+	folder := &Folder{DisplayName: fuc.displayName}
+	if _, err := fuc.service.session.Patch(ctx, path, folder, folder, opts...); err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// FolderMoveCall struct allowing for fluent style configuration of calls to the mailFolder move and copy endpoints.
+type FolderMoveCall struct {
+	service       *FolderService
+	folderID      string
+	destinationID string
+	action        string
+}
+
+// Move returns an instance of a FolderMoveCall moving the given folder, along with its contents, into the destination folder.
+func (fs *FolderService) Move(folderID, destinationID string) *FolderMoveCall {
+	return &FolderMoveCall{
+		service:       fs,
+		folderID:      folderID,
+		destinationID: destinationID,
+		action:        "move",
+	}
+}
+
+// Copy returns an instance of a FolderMoveCall copying the given folder, along with its contents, into the destination folder.
+func (fs *FolderService) Copy(folderID, destinationID string) *FolderMoveCall {
+	return &FolderMoveCall{
+		service:       fs,
+		folderID:      folderID,
+		destinationID: destinationID,
+		action:        "copy",
+	}
+}
+
+// Do executes the http post request to microsoft's graph api, returning the moved folder or the new copy.
+func (fmc *FolderMoveCall) Do(ctx context.Context, opts ...RequestOption) (*Folder, error) {
+	path := fmt.Sprintf("%s/%s/%s", fmc.service.basePath, fmc.folderID, fmc.action)
+	body := map[string]interface{}{
+		"destinationId": fmc.destinationID,
+	}
+	folder := Folder{}
+	if _, err := fmc.service.session.Post(ctx, path, body, &folder, opts...); err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// FolderDeleteCall struct allowing for fluent style configuration of calls to the mailFolder delete endpoint.
+type FolderDeleteCall struct {
+	service   *FolderService
+	folderID  string
+	permanent bool
+}
+
+// Delete returns an instance of a FolderDeleteCall deleting the given folder, which graph moves to Deleted Items along with its contents.
+func (fs *FolderService) Delete(folderID string) *FolderDeleteCall {
+	return &FolderDeleteCall{
+		service:  fs,
+		folderID: folderID,
+	}
+}
+
+// Permanent permanently deletes the folder and its contents, bypassing Deleted Items, instead of moving them there.
+func (fdc *FolderDeleteCall) Permanent(permanent bool) *FolderDeleteCall {
+	fdc.permanent = permanent
+	return fdc
+}
+
+// Do executes the http request to microsoft's graph api to delete the call's folder.
+func (fdc *FolderDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", fdc.service.basePath, fdc.folderID)
+	if fdc.permanent {
+		_, err := fdc.service.session.Post(ctx, path+"/permanentDelete", nil, nil, opts...)
+		return err
+	}
+	if _, err := fdc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FolderChildListCall struct allowing for fluent style configuration of calls to the mailFolder childFolders endpoint.
+type FolderChildListCall struct {
+	service       *FolderService
+	folderID      string
+	nextLink      string
+	maxResults    int64
+	includeHidden bool
+}
+
+// ListChildFolders returns a FolderChildListCall listing the folders directly under the given folder.
+func (fs *FolderService) ListChildFolders(folderID string) *FolderChildListCall {
+	return &FolderChildListCall{
+		service:    fs,
+		folderID:   folderID,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the child folder list call.
+func (fclc *FolderChildListCall) MaxResults(pageSize int64) *FolderChildListCall {
+	fclc.maxResults = pageSize
+	return fclc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the child folder list call.
+func (fclc *FolderChildListCall) NextLink(link string) *FolderChildListCall {
+	fclc.nextLink = link
+	return fclc
+}
+
+// IncludeHidden sets the includeHiddenFolders query parameter, so hidden folders are listed too.
+func (fclc *FolderChildListCall) IncludeHidden(include bool) *FolderChildListCall {
+	fclc.includeHidden = include
+	return fclc
+}
+
+// Do executes the child folder list call, returning the folder list result.
+func (fclc *FolderChildListCall) Do(ctx context.Context, opts ...RequestOption) (*FolderListResult, error) {
 	var result FolderListResult
-	if _, err := flc.service.session.Get(ctx, flc.service.basePath, params, &result); err != nil {
+	if _, err := fclc.do(ctx, &result, opts); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
+
+// DoResponse executes the child folder list call, returning the page along with its paging and correlation metadata.
+func (fclc *FolderChildListCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Folder], error) {
+	var page odataCollection[*Folder]
+	res, err := fclc.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (fclc *FolderChildListCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
+	params := map[string]interface{}{
+		"$top":   fclc.maxResults,
+		"$count": true,
+	}
+	if fclc.includeHidden {
+		params["includeHiddenFolders"] = true
+	}
+	if fclc.nextLink != "" {
+		params["$skip"] = parsePageLink(fclc.nextLink, "$skip")
+	}
+
+	path := fmt.Sprintf("%s/%s/childFolders", fclc.service.basePath, fclc.folderID)
+	return fclc.service.session.Get(ctx, path, params, result, opts...)
+}
@@ -0,0 +1,64 @@
+package outlook
+
+import "context"
+
+// FolderStats counts aggregated across a folder tree.
+type FolderStats struct {
+	FolderCount     int
+	TotalItemCount  int
+	UnreadItemCount int
+	// SizeInBytes the summed size of the folders, which graph does not report for every mailbox; folders without a size count as zero.
+	SizeInBytes int64
+}
+
+func (fs *FolderStats) add(folder *Folder) {
+	fs.FolderCount++
+	fs.TotalItemCount += folder.TotalItemCount
+	fs.UnreadItemCount += folder.UnreadItemCount
+	fs.SizeInBytes += folder.SizeInBytes
+}
+
+// FolderStatsCall struct allowing for fluent style configuration of calls aggregating the counts of a folder tree.
+type FolderStatsCall struct {
+	service       *FolderService
+	rootID        string
+	includeHidden bool
+}
+
+// Stats returns an instance of a FolderStatsCall aggregating the counts of rootID and every folder below it, or of the whole mailbox when rootID is empty.
+func (fs *FolderService) Stats(rootID string) *FolderStatsCall {
+	return &FolderStatsCall{
+		service: fs,
+		rootID:  rootID,
+	}
+}
+
+// IncludeHidden also counts hidden folders and their children.
+func (fsc *FolderStatsCall) IncludeHidden(include bool) *FolderStatsCall {
+	fsc.includeHidden = include
+	return fsc
+}
+
+// Do executes the call, walking the tree and returning its aggregated counts.
+func (fsc *FolderStatsCall) Do(ctx context.Context, opts ...RequestOption) (*FolderStats, error) {
+	stats := &FolderStats{}
+	if fsc.rootID != "" {
+		root, err := fsc.service.Get(fsc.rootID).Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		stats.add(root)
+		if root.ChildFolderCount == 0 {
+			return stats, nil
+		}
+	}
+
+	walk := fsc.service.Walk(fsc.rootID, func(folder *Folder, depth int) error {
+		stats.add(folder)
+		return nil
+	})
+	if err := walk.IncludeHidden(fsc.includeHidden).Do(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
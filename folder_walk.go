@@ -0,0 +1,96 @@
+package outlook
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSkipFolder may be returned by a FolderWalkFunc to skip the folder's children. It is never returned by FolderWalkCall.Do.
+var ErrSkipFolder = errors.New("skip this folder's children")
+
+// FolderWalkFunc the function called for every folder visited by a FolderWalkCall. depth is 0 for the root's children.
+// Returning ErrSkipFolder skips the folder's children, any other error stops the walk and is returned by Do.
+type FolderWalkFunc func(folder *Folder, depth int) error
+
+// FolderWalkCall struct allowing for fluent style configuration of a depth-first traversal of a folder hierarchy.
+type FolderWalkCall struct {
+	service       *FolderService
+	rootID        string
+	fn            FolderWalkFunc
+	pageSize      int64
+	includeHidden bool
+}
+
+// Walk returns an instance of a FolderWalkCall visiting every folder below rootID, or below the top of the mailbox when rootID is empty.
+// The root itself is not visited.
+func (fs *FolderService) Walk(rootID string, fn FolderWalkFunc) *FolderWalkCall {
+	return &FolderWalkCall{
+		service:  fs,
+		rootID:   rootID,
+		fn:       fn,
+		pageSize: 100,
+	}
+}
+
+// IncludeHidden also visits hidden folders and their children.
+func (fwc *FolderWalkCall) IncludeHidden(include bool) *FolderWalkCall {
+	fwc.includeHidden = include
+	return fwc
+}
+
+// PageSize sets the $top query parameter used for each page of child folders the walk fetches.
+func (fwc *FolderWalkCall) PageSize(pageSize int64) *FolderWalkCall {
+	fwc.pageSize = pageSize
+	return fwc
+}
+
+// Do executes the walk, calling fn for each folder in depth-first order, parents before their children.
+func (fwc *FolderWalkCall) Do(ctx context.Context, opts ...RequestOption) error {
+	return fwc.walk(ctx, fwc.rootID, 0, opts)
+}
+
+func (fwc *FolderWalkCall) walk(ctx context.Context, parentID string, depth int, opts []RequestOption) error {
+	children, err := fwc.children(ctx, parentID, opts)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		err := fwc.fn(child, depth)
+		if errors.Is(err, ErrSkipFolder) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if child.ChildFolderCount == 0 {
+			continue
+		}
+		if err := fwc.walk(ctx, child.ID, depth+1, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// children fetches every page of parentID's child folders.
+func (fwc *FolderWalkCall) children(ctx context.Context, parentID string, opts []RequestOption) ([]*Folder, error) {
+	var folders []*Folder
+	nextLink := ""
+	for {
+		var page *FolderListResult
+		var err error
+		if parentID == "" {
+			page, err = fwc.service.List().MaxResults(fwc.pageSize).IncludeHidden(fwc.includeHidden).NextLink(nextLink).Do(ctx, opts...)
+		} else {
+			page, err = fwc.service.ListChildFolders(parentID).MaxResults(fwc.pageSize).IncludeHidden(fwc.includeHidden).NextLink(nextLink).Do(ctx, opts...)
+		}
+		if err != nil {
+			return nil, err
+		}
+		folders = append(folders, page.Value...)
+		if page.NextLink == "" {
+			return folders, nil
+		}
+		nextLink = page.NextLink
+	}
+}
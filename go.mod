@@ -2,4 +2,19 @@ module github.com/ntauth/go-outlook
 
 go 1.20
 
-require golang.org/x/oauth2 v0.24.0
+require (
+	github.com/Azure/azure-sdk-for-go/sdk/azcore v1.11.1
+	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/trace v1.24.0
+	golang.org/x/net v0.22.0
+	golang.org/x/oauth2 v0.24.0
+	golang.org/x/time v0.9.0
+)
+
+require (
+	github.com/Azure/azure-sdk-for-go/sdk/internal v1.5.2 // indirect
+	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/otel/metric v1.24.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+)
@@ -0,0 +1,281 @@
+package outlook
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HTMLToText converts an html message or event body to readable plain text, e.g. for search indexing or as input to a
+// language model, where bodyPreview is too short and raw html too noisy. Paragraphs and headings are separated by blank
+// lines, lists are rendered with "-" or numbered markers, blockquotes, such as quoted replies, are prefixed with "> ",
+// links are followed by their target in angle brackets, and images are replaced by their alt text. Scripts, styles,
+// and the document head are dropped.
+func HTMLToText(content string) string {
+	document, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		// html.Parse only fails on read errors, which a strings.Reader does not return.
+		return content
+	}
+	converter := &htmlTextConverter{}
+	converter.walk(document)
+	return converter.String()
+}
+
+// Text returns the body's content as plain text, converting html bodies with HTMLToText.
+func (mb *MessageBody) Text() string {
+	if mb == nil {
+		return ""
+	}
+	if strings.EqualFold(mb.ContentType, BodyContentTypeHTML) {
+		return HTMLToText(mb.Content)
+	}
+	return mb.Content
+}
+
+// htmlTextConverter renders a parsed html document as plain text, line by line.
+type htmlTextConverter struct {
+	buf strings.Builder
+	// prefixes what each line starts with at the current depth, e.g. "> " inside a blockquote or indentation inside a list item.
+	prefixes []string
+	// marker replaces the innermost prefix on the next line started, e.g. the "- " of a list item.
+	marker string
+	// newlines the line breaks to write before the next text, at most two, a blank line.
+	newlines int
+	// lineStarted whether text was written to the current line.
+	lineStarted bool
+	// pre the depth of pre elements, inside of which whitespace is kept.
+	pre int
+	// lists the item counters of the enclosing lists, 0 for unordered lists.
+	lists []int
+	// linePrefix the prefix the current, or last, line was started with.
+	linePrefix string
+}
+
+func (c *htmlTextConverter) String() string {
+	lines := strings.Split(c.buf.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func (c *htmlTextConverter) walk(node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		c.text(node.Data)
+		return
+	case html.ElementNode:
+	default:
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			c.walk(child)
+		}
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Title, atom.Template, atom.Noscript:
+		return
+	case atom.Br:
+		c.breakLine(1, true)
+		return
+	case atom.Hr:
+		c.breakLine(1, false)
+		c.text("----")
+		c.breakLine(1, false)
+		return
+	case atom.Img:
+		if alt := strings.TrimSpace(htmlAttribute(node, "alt")); alt != "" {
+			c.text("[" + alt + "]")
+		}
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.P, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Table:
+		c.breakLine(2, false)
+		defer c.breakLine(2, false)
+	case atom.Div, atom.Tr, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Address, atom.Center, atom.Dt, atom.Dd:
+		c.breakLine(1, false)
+		defer c.breakLine(1, false)
+	case atom.Pre:
+		c.breakLine(2, false)
+		c.pre++
+		defer func() {
+			c.pre--
+			c.breakLine(2, false)
+		}()
+	case atom.Blockquote:
+		c.breakLine(2, false)
+		c.prefixes = append(c.prefixes, "> ")
+		defer func() {
+			c.prefixes = c.prefixes[:len(c.prefixes)-1]
+			c.breakLine(2, false)
+		}()
+	case atom.Ul, atom.Ol:
+		c.breakLine(1, false)
+		c.lists = append(c.lists, 0)
+		if node.DataAtom == atom.Ol {
+			c.lists[len(c.lists)-1] = 1
+		}
+		defer func() {
+			c.lists = c.lists[:len(c.lists)-1]
+			c.breakLine(1, false)
+		}()
+	case atom.Li:
+		marker := "- "
+		if depth := len(c.lists); depth > 0 && c.lists[depth-1] > 0 {
+			marker = fmt.Sprintf("%d. ", c.lists[depth-1])
+			c.lists[depth-1]++
+		}
+		c.breakLine(1, false)
+		c.prefixes = append(c.prefixes, strings.Repeat(" ", len(marker)))
+		c.marker = marker
+		defer func() {
+			c.prefixes = c.prefixes[:len(c.prefixes)-1]
+			c.breakLine(1, false)
+		}()
+	case atom.Td, atom.Th:
+		if c.lineStarted {
+			c.text(" | ")
+		}
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		c.walk(child)
+	}
+
+	if node.DataAtom == atom.A {
+		href := strings.TrimSpace(htmlAttribute(node, "href"))
+		if href != "" && !strings.HasPrefix(href, "#") && !strings.HasPrefix(strings.ToLower(href), "javascript:") &&
+			!strings.Contains(c.lastLine(), href) && strings.TrimPrefix(href, "mailto:") != strings.TrimSpace(htmlNodeText(node)) {
+			c.text(" <" + href + ">")
+		}
+	}
+}
+
+// breakLine ends the current line, asking for n line breaks, 2 for a blank line, before the next text. Unless forced, as
+// by a br, breaks are only written after text, so nested blocks don't stack up blank lines.
+func (c *htmlTextConverter) breakLine(n int, force bool) {
+	if !c.lineStarted && !force {
+		if c.newlines > 0 && n > c.newlines {
+			c.newlines = n
+		}
+		return
+	}
+	if force && !c.lineStarted {
+		c.newlines++
+	} else if n > c.newlines {
+		c.newlines = n
+	}
+	if c.newlines > 2 {
+		c.newlines = 2
+	}
+	c.lineStarted = false
+}
+
+func (c *htmlTextConverter) text(data string) {
+	if c.pre == 0 {
+		data = collapseSpace(data)
+		if !c.lineStarted || strings.HasSuffix(c.buf.String(), " ") {
+			data = strings.TrimLeft(data, " ")
+		}
+		if data != "" {
+			c.write(data)
+		}
+		return
+	}
+
+	for i, line := range strings.Split(data, "\n") {
+		if i > 0 {
+			c.breakLine(1, true)
+		}
+		if line != "" {
+			c.write(line)
+		}
+	}
+}
+
+// write writes text to the current line, first writing any pending line breaks and the new line's prefix.
+func (c *htmlTextConverter) write(text string) {
+	if !c.lineStarted {
+		prefix := strings.Join(c.prefixes, "")
+		if c.buf.Len() > 0 {
+			// Blank lines between blocks only carry the prefix the blocks on either side share, e.g. none before a blockquote.
+			blank := strings.TrimRight(commonPrefix(c.linePrefix, prefix), " ")
+			for i := 0; i < c.newlines; i++ {
+				c.buf.WriteString("\n")
+				if i < c.newlines-1 {
+					c.buf.WriteString(blank)
+				}
+			}
+		}
+		c.newlines = 0
+		c.linePrefix = prefix
+		if c.marker != "" && len(c.prefixes) > 0 {
+			prefix = strings.Join(c.prefixes[:len(c.prefixes)-1], "") + c.marker
+			c.marker = ""
+		}
+		c.buf.WriteString(prefix)
+		c.lineStarted = true
+	}
+	c.buf.WriteString(text)
+}
+
+// lastLine returns the line being written.
+func (c *htmlTextConverter) lastLine() string {
+	written := c.buf.String()
+	return written[strings.LastIndex(written, "\n")+1:]
+}
+
+func htmlAttribute(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func htmlNodeText(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	var text strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		text.WriteString(htmlNodeText(child))
+	}
+	return text.String()
+}
+
+// collapseSpace replaces each run of whitespace, non breaking spaces included, with a single space, as browsers render it.
+func collapseSpace(s string) string {
+	var collapsed strings.Builder
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			collapsed.WriteByte(' ')
+			space = false
+		}
+		collapsed.WriteRune(r)
+	}
+	if space {
+		collapsed.WriteByte(' ')
+	}
+	return collapsed.String()
+}
+
+func commonPrefix(a, b string) string {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
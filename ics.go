@@ -0,0 +1,261 @@
+package outlook
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsDateFormat      = "20060102"
+	icsDateTimeFormat  = "20060102T150405"
+	icsLineLength      = 75
+	icsProductID       = "-//ntauth//go-outlook//EN"
+	icsDefaultReminder = 15
+)
+
+// WriteICS writes events to w as an iCalendar (RFC 5545) VCALENDAR, one VEVENT per event, with their recurrence, organizer,
+// attendees, and reminder. Times are written with the IANA identifier of the event's time zone as TZID, Windows names being
+// converted; no VTIMEZONE definitions are written, which calendar systems resolving IANA identifiers, as most do, do not need.
+// Events read with a custom $select must include the fields DefaultEventFields lists, sensitivity in particular: events
+// without one are written as CLASS:PUBLIC.
+func WriteICS(w io.Writer, events ...*Event) error {
+	iw := &icsWriter{w: bufio.NewWriter(w)}
+	iw.line("BEGIN", "VCALENDAR")
+	iw.line("VERSION", "2.0")
+	iw.line("PRODID", icsProductID)
+	iw.line("CALSCALE", "GREGORIAN")
+	iw.line("METHOD", "PUBLISH")
+	for _, event := range events {
+		if err := iw.event(event); err != nil {
+			return err
+		}
+	}
+	iw.line("END", "VCALENDAR")
+	if iw.err != nil {
+		return iw.err
+	}
+	return iw.w.Flush()
+}
+
+// icsWriter writes content lines, folding and terminating them as iCalendar requires and keeping the first write error.
+type icsWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (iw *icsWriter) line(name, value string) {
+	if iw.err != nil {
+		return
+	}
+	line := name + ":" + value
+	// Continuation lines start with the space which marks them as such, leaving one octet less for content.
+	for limit := icsLineLength; len(line) > limit; limit = icsLineLength - 1 {
+		// Fold on a character boundary so multi byte characters are not split across lines.
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		if _, iw.err = iw.w.WriteString(line[:cut] + "\r\n "); iw.err != nil {
+			return
+		}
+		line = line[cut:]
+	}
+	_, iw.err = iw.w.WriteString(line + "\r\n")
+}
+
+func (iw *icsWriter) event(event *Event) error {
+	uid := event.ICalUID
+	if uid == "" {
+		uid = event.ID
+	}
+	if uid == "" {
+		return fmt.Errorf("ics: event %q has neither an iCalUId nor an id", event.Subject)
+	}
+
+	iw.line("BEGIN", "VEVENT")
+	iw.line("UID", icsText(uid))
+	iw.line("DTSTAMP", icsStamp(event.UpdatedOn))
+	if event.CreatedOn != "" {
+		iw.line("CREATED", icsStamp(event.CreatedOn))
+	}
+	if err := iw.dateTime("DTSTART", event.Start, event.AllDay); err != nil {
+		return err
+	}
+	if err := iw.dateTime("DTEND", event.End, event.AllDay); err != nil {
+		return err
+	}
+	if event.Recurrence != nil {
+		rule, err := icsRRule(event)
+		if err != nil {
+			return fmt.Errorf("ics: event %s: %w", uid, err)
+		}
+		iw.line("RRULE", rule)
+	}
+
+	iw.line("SUMMARY", icsText(event.Subject))
+	if event.Body != nil && event.Body.Content != "" {
+		if strings.EqualFold(event.Body.ContentType, BodyContentTypeHTML) {
+			iw.line("DESCRIPTION", icsText(event.BodyPreview))
+			iw.line("X-ALT-DESC;FMTTYPE=text/html", icsText(event.Body.Content))
+		} else {
+			iw.line("DESCRIPTION", icsText(event.Body.Content))
+		}
+	}
+	if event.Location != nil && event.Location.DisplayName != "" {
+		iw.line("LOCATION", icsText(event.Location.DisplayName))
+	}
+	if len(event.Categories) > 0 {
+		categories := make([]string, len(event.Categories))
+		for i, category := range event.Categories {
+			categories[i] = icsText(category)
+		}
+		iw.line("CATEGORIES", strings.Join(categories, ","))
+	}
+	if event.OnlineMeeting != nil && event.OnlineMeeting.JoinURL != "" {
+		iw.line("URL", event.OnlineMeeting.JoinURL)
+	}
+
+	iw.line("STATUS", icsStatus(event))
+	iw.line("CLASS", icsClass(event.Sensitivity))
+	if event.ShowAs == EventShowAsFree {
+		iw.line("TRANSP", "TRANSPARENT")
+	} else {
+		iw.line("TRANSP", "OPAQUE")
+	}
+	switch event.Importance {
+	case ImportanceHigh:
+		iw.line("PRIORITY", "1")
+	case ImportanceLow:
+		iw.line("PRIORITY", "9")
+	}
+
+	if event.Organizer != nil && event.Organizer.EmailAddress != nil {
+		iw.line("ORGANIZER"+icsCommonName(event.Organizer.EmailAddress), "mailto:"+event.Organizer.EmailAddress.Address)
+	}
+	for _, attendee := range event.Attendees {
+		if attendee.EmailAddress == nil {
+			continue
+		}
+		iw.line("ATTENDEE"+icsAttendeeParams(attendee), "mailto:"+attendee.EmailAddress.Address)
+	}
+
+	if event.ReminderOn {
+		minutes := event.ReminderMinutesBeforeStart
+		if minutes <= 0 {
+			minutes = icsDefaultReminder
+		}
+		iw.line("BEGIN", "VALARM")
+		iw.line("ACTION", "DISPLAY")
+		iw.line("DESCRIPTION", "Reminder")
+		iw.line("TRIGGER", fmt.Sprintf("-PT%dM", minutes))
+		iw.line("END", "VALARM")
+	}
+	iw.line("END", "VEVENT")
+	return iw.err
+}
+
+// dateTime writes a DTSTART or DTEND property, as a date for all day events and otherwise as a local time with its TZID.
+func (iw *icsWriter) dateTime(name string, dt *DateTimeTimeZone, allDay bool) error {
+	t, err := dt.ToTime()
+	if err != nil {
+		return fmt.Errorf("ics: %s: %w", strings.ToLower(name), err)
+	}
+	switch {
+	case allDay:
+		iw.line(name+";VALUE=DATE", t.Format(icsDateFormat))
+	case t.Location() == time.UTC || t.Location().String() == "Etc/UTC":
+		iw.line(name, t.Format(icsDateTimeFormat)+"Z")
+	default:
+		iw.line(name+";TZID="+t.Location().String(), t.Format(icsDateTimeFormat))
+	}
+	return nil
+}
+
+// icsRRule returns the event's recurrence as an RRULE value whose UNTIL has the value type of its DTSTART, a date for all day
+// events and a UTC date time otherwise.
+func icsRRule(event *Event) (string, error) {
+	if event.AllDay {
+		return event.Recurrence.RRule()
+	}
+	start, err := event.Start.ToTime()
+	if err != nil {
+		return "", err
+	}
+	return event.Recurrence.RRuleTimed(start.Location())
+}
+
+// icsStamp formats a graph timestamp as an iCalendar UTC date time, using the current time when it is missing or invalid.
+func icsStamp(timestamp string) string {
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		t = time.Now()
+	}
+	return t.UTC().Format(icsDateTimeFormat) + "Z"
+}
+
+func icsStatus(event *Event) string {
+	switch {
+	case event.IsCancelled:
+		return "CANCELLED"
+	case event.ShowAs == EventShowAsTentative:
+		return "TENTATIVE"
+	default:
+		return "CONFIRMED"
+	}
+}
+
+func icsClass(sensitivity Sensitivity) string {
+	switch sensitivity {
+	case SensitivityPrivate, SensitivityPersonal:
+		return "PRIVATE"
+	case SensitivityConfidential:
+		return "CONFIDENTIAL"
+	default:
+		return "PUBLIC"
+	}
+}
+
+func icsAttendeeParams(attendee *Attendee) string {
+	params := icsCommonName(attendee.EmailAddress)
+	switch attendee.Type {
+	case AttendeeTypeOptional:
+		params += ";ROLE=OPT-PARTICIPANT"
+	case AttendeeTypeResource:
+		params += ";CUTYPE=RESOURCE;ROLE=NON-PARTICIPANT"
+	default:
+		params += ";ROLE=REQ-PARTICIPANT"
+	}
+
+	partstat := "NEEDS-ACTION"
+	if attendee.Status != nil {
+		switch attendee.Status.Response {
+		case ResponseTypeAccepted, ResponseTypeOrganizer:
+			partstat = "ACCEPTED"
+		case ResponseTypeTentativelyAccepted:
+			partstat = "TENTATIVE"
+		case ResponseTypeDeclined:
+			partstat = "DECLINED"
+		}
+	}
+	return params + ";PARTSTAT=" + partstat
+}
+
+// icsCommonName returns the CN parameter of an address, quoted as parameter values containing delimiters must be.
+func icsCommonName(address *EmailAddress) string {
+	if address.Name == "" {
+		return ""
+	}
+	name := strings.NewReplacer(`"`, "'", "\r", "", "\n", " ").Replace(address.Name)
+	return fmt.Sprintf(`;CN="%s"`, name)
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// icsText escapes a TEXT property value.
+func icsText(text string) string {
+	return icsTextEscaper.Replace(text)
+}
@@ -0,0 +1,369 @@
+package outlook
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var icsDurationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// icsProperty a content line, e.g. DTSTART;TZID=Europe/Berlin:20240304T090000.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICS parses every VEVENT of an iCalendar (RFC 5545) stream into an Event ready for EventService.Create, including its
+// RRULE recurrence, organizer, attendees, and first display alarm. The event's UID is carried as its TransactionID, so creating
+// the same invitation twice does not duplicate it. Events overriding a single occurrence of a series, those with a RECURRENCE-ID,
+// carry it as their OriginalStart. TZIDs must be IANA identifiers or Windows names, as written by Outlook;
+// VTIMEZONE definitions are not interpreted, and EXDATE and RDATE properties are ignored.
+func ParseICS(r io.Reader) ([]*Event, error) {
+	_, events, err := parseICS(r)
+	return events, err
+}
+
+// parseICS parses an iCalendar stream, returning its METHOD, if any, along with its events.
+func parseICS(r io.Reader) (string, []*Event, error) {
+	lines, err := icsUnfold(r)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var events []*Event
+	var event *Event
+	var start *icsProperty
+	var end, duration *icsProperty
+	var rrule, method string
+	var components []string
+	for _, line := range lines {
+		prop, err := parseICSProperty(line)
+		if err != nil {
+			return "", nil, err
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			components = append(components, strings.ToUpper(prop.value))
+			if strings.EqualFold(prop.value, "VEVENT") {
+				event, start, end, duration, rrule = &Event{}, nil, nil, nil, ""
+			}
+			continue
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(prop.value) {
+				return "", nil, fmt.Errorf("ics: unexpected END:%s", prop.value)
+			}
+			components = components[:len(components)-1]
+			if strings.EqualFold(prop.value, "VEVENT") {
+				if err := completeICSEvent(event, start, end, duration, rrule); err != nil {
+					return "", nil, err
+				}
+				events = append(events, event)
+				event = nil
+			}
+			continue
+		}
+
+		if event == nil {
+			if prop.name == "METHOD" && len(components) == 1 && components[0] == "VCALENDAR" {
+				method = strings.ToUpper(prop.value)
+			}
+			continue
+		}
+		if components[len(components)-1] == "VALARM" {
+			if prop.name == "TRIGGER" && !event.ReminderOn {
+				if before, err := parseICSDuration(prop.value); err == nil && before <= 0 {
+					event.ReminderOn = true
+					event.ReminderMinutesBeforeStart = int(-before / time.Minute)
+				}
+			}
+			continue
+		}
+
+		switch prop.name {
+		case "UID":
+			event.TransactionID = icsUnescape(prop.value)
+		case "DTSTART":
+			start = prop
+		case "DTEND":
+			end = prop
+		case "DURATION":
+			duration = prop
+		case "RRULE":
+			rrule = prop.value
+		case "RECURRENCE-ID":
+			originalStart, _, err := parseICSDateTime(prop)
+			if err != nil {
+				return "", nil, err
+			}
+			event.OriginalStart = originalStart.UTC().Format(time.RFC3339)
+		case "SUMMARY":
+			event.Subject = icsUnescape(prop.value)
+		case "DESCRIPTION":
+			if event.Body == nil {
+				event.Body = &MessageBody{ContentType: BodyContentTypeText, Content: icsUnescape(prop.value)}
+			}
+		case "X-ALT-DESC":
+			if strings.EqualFold(prop.params["FMTTYPE"], "text/html") {
+				event.Body = &MessageBody{ContentType: BodyContentTypeHTML, Content: icsUnescape(prop.value)}
+			}
+		case "LOCATION":
+			event.Location = &Location{DisplayName: icsUnescape(prop.value)}
+		case "CATEGORIES":
+			for _, category := range icsSplit(prop.value) {
+				event.Categories = append(event.Categories, icsUnescape(category))
+			}
+		case "ORGANIZER":
+			event.Organizer = &Recipient{EmailAddress: icsAddress(prop)}
+		case "ATTENDEE":
+			event.Attendees = append(event.Attendees, icsAttendee(prop))
+		case "CLASS":
+			switch strings.ToUpper(prop.value) {
+			case "PRIVATE":
+				event.Sensitivity = SensitivityPrivate
+			case "CONFIDENTIAL":
+				event.Sensitivity = SensitivityConfidential
+			}
+		case "TRANSP":
+			if strings.EqualFold(prop.value, "TRANSPARENT") {
+				event.ShowAs = EventShowAsFree
+			}
+		case "STATUS":
+			if strings.EqualFold(prop.value, "TENTATIVE") && event.ShowAs == "" {
+				event.ShowAs = EventShowAsTentative
+			}
+		case "PRIORITY":
+			switch priority, _ := strconv.Atoi(prop.value); {
+			case priority >= 1 && priority <= 4:
+				event.Importance = ImportanceHigh
+			case priority >= 6:
+				event.Importance = ImportanceLow
+			}
+		}
+	}
+	if len(components) > 0 {
+		return "", nil, fmt.Errorf("ics: unterminated %s", components[len(components)-1])
+	}
+	return method, events, nil
+}
+
+// completeICSEvent sets the event's start, end, and recurrence once all of its properties have been read.
+func completeICSEvent(event *Event, startProp, endProp, durationProp *icsProperty, rrule string) error {
+	if startProp == nil {
+		return fmt.Errorf("ics: event %q has no DTSTART", event.Subject)
+	}
+	start, allDay, err := parseICSDateTime(startProp)
+	if err != nil {
+		return err
+	}
+
+	var end time.Time
+	switch {
+	case endProp != nil:
+		if end, _, err = parseICSDateTime(endProp); err != nil {
+			return err
+		}
+	case durationProp != nil:
+		d, err := parseICSDuration(durationProp.value)
+		if err != nil {
+			return err
+		}
+		end = start.Add(d)
+	case allDay:
+		end = start.AddDate(0, 0, 1)
+	default:
+		end = start
+	}
+
+	event.AllDay = allDay
+	event.Start = NewDateTimeTimeZone(start)
+	event.End = NewDateTimeTimeZone(end)
+	if rrule != "" {
+		recurrence, err := ParseRRule(rrule, start)
+		if err != nil {
+			return fmt.Errorf("ics: event %q: %w", event.Subject, err)
+		}
+		event.Recurrence = recurrence
+	}
+	return nil
+}
+
+// icsUnfold reads the content lines of r, joining folded lines.
+func icsUnfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseICSProperty splits a content line into its name, parameters, and value, honouring quoted parameter values.
+func parseICSProperty(line string) (*icsProperty, error) {
+	prop := &icsProperty{params: map[string]string{}}
+	quoted := false
+	nameEnd, valueStart := -1, -1
+	var paramStarts []int
+	for i, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';':
+			if nameEnd < 0 {
+				nameEnd = i
+			}
+			paramStarts = append(paramStarts, i+1)
+		case c == ':':
+			if nameEnd < 0 {
+				nameEnd = i
+			}
+			valueStart = i + 1
+		}
+		if valueStart >= 0 {
+			break
+		}
+	}
+	if valueStart < 0 {
+		return nil, fmt.Errorf("ics: invalid content line %q", line)
+	}
+
+	prop.name = strings.ToUpper(line[:nameEnd])
+	prop.value = line[valueStart:]
+	for i, paramStart := range paramStarts {
+		paramEnd := valueStart - 1
+		if i+1 < len(paramStarts) {
+			paramEnd = paramStarts[i+1] - 1
+		}
+		key, value, _ := strings.Cut(line[paramStart:paramEnd], "=")
+		prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+// parseICSDateTime parses a DATE or DATE-TIME property, in UTC, in its TZID, or, for floating times, in UTC.
+func parseICSDateTime(prop *icsProperty) (time.Time, bool, error) {
+	value := prop.value
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(value) == len(icsDateFormat) {
+		t, err := time.Parse(icsDateFormat, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("ics: invalid %s %q", prop.name, value)
+		}
+		return t, true, nil
+	}
+
+	loc := time.UTC
+	if strings.HasSuffix(value, "Z") {
+		value = strings.TrimSuffix(value, "Z")
+	} else if tzid := prop.params["TZID"]; tzid != "" {
+		var err error
+		if loc, err = LoadTimeZone(strings.TrimPrefix(tzid, "/")); err != nil {
+			return time.Time{}, false, fmt.Errorf("ics: %s: %w", prop.name, err)
+		}
+	}
+	t, err := time.ParseInLocation(icsDateTimeFormat, value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("ics: invalid %s %q", prop.name, prop.value)
+	}
+	return t, false, nil
+}
+
+// parseICSDuration parses an RFC 5545 duration, e.g. -PT15M or P1DT2H.
+func parseICSDuration(value string) (time.Duration, error) {
+	m := icsDurationPattern.FindStringSubmatch(strings.ToUpper(value))
+	if m == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("ics: invalid duration %q", value)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if n, err := strconv.Atoi(m[i+2]); err == nil {
+			d += time.Duration(n) * unit
+		}
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+func icsAddress(prop *icsProperty) *EmailAddress {
+	address := prop.value
+	if len(address) > len("mailto:") && strings.EqualFold(address[:len("mailto:")], "mailto:") {
+		address = address[len("mailto:"):]
+	}
+	return &EmailAddress{Name: prop.params["CN"], Address: address}
+}
+
+func icsAttendee(prop *icsProperty) *Attendee {
+	attendee := &Attendee{Type: AttendeeTypeRequired, EmailAddress: icsAddress(prop)}
+	switch {
+	case strings.EqualFold(prop.params["CUTYPE"], "RESOURCE") || strings.EqualFold(prop.params["CUTYPE"], "ROOM"):
+		attendee.Type = AttendeeTypeResource
+	case strings.EqualFold(prop.params["ROLE"], "OPT-PARTICIPANT") || strings.EqualFold(prop.params["ROLE"], "NON-PARTICIPANT"):
+		attendee.Type = AttendeeTypeOptional
+	}
+	switch strings.ToUpper(prop.params["PARTSTAT"]) {
+	case "ACCEPTED":
+		attendee.Status = &ResponseStatus{Response: ResponseTypeAccepted}
+	case "TENTATIVE":
+		attendee.Status = &ResponseStatus{Response: ResponseTypeTentativelyAccepted}
+	case "DECLINED":
+		attendee.Status = &ResponseStatus{Response: ResponseTypeDeclined}
+	case "NEEDS-ACTION":
+		attendee.Status = &ResponseStatus{Response: ResponseTypeNotResponded}
+	}
+	return attendee
+}
+
+// icsSplit splits a list value on the commas which are not escaped.
+func icsSplit(value string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// icsUnescape reverses icsText.
+func icsUnescape(text string) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 == len(text) {
+			b.WriteByte(text[i])
+			continue
+		}
+		i++
+		switch text[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,106 @@
+package outlook
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Importance the importance of a message or event.
+type Importance string
+
+// Importance enum
+const (
+	ImportanceLow    Importance = "low"
+	ImportanceNormal Importance = "normal"
+	ImportanceHigh   Importance = "high"
+)
+
+// ParseImportance returns the Importance named by s, or an error if graph does not know it.
+func ParseImportance(s string) (Importance, error) {
+	importance := Importance(s)
+	if !importance.Valid() {
+		return "", fmt.Errorf("outlook: invalid importance %q", s)
+	}
+	return importance, nil
+}
+
+// Valid reports whether the importance is one graph accepts.
+func (i Importance) Valid() bool {
+	switch i {
+	case ImportanceLow, ImportanceNormal, ImportanceHigh:
+		return true
+	}
+	return false
+}
+
+// Filter returns the $filter clause matching items of this importance, e.g. "importance eq 'high'".
+func (i Importance) Filter() string {
+	return fmt.Sprintf("importance eq '%s'", i)
+}
+
+// Sensitivity the sensitivity of a message or event.
+type Sensitivity string
+
+// Sensitivity enum
+const (
+	SensitivityNormal       Sensitivity = "normal"
+	SensitivityPersonal     Sensitivity = "personal"
+	SensitivityPrivate      Sensitivity = "private"
+	SensitivityConfidential Sensitivity = "confidential"
+)
+
+// ParseSensitivity returns the Sensitivity named by s, or an error if graph does not know it.
+func ParseSensitivity(s string) (Sensitivity, error) {
+	sensitivity := Sensitivity(s)
+	if !sensitivity.Valid() {
+		return "", fmt.Errorf("outlook: invalid sensitivity %q", s)
+	}
+	return sensitivity, nil
+}
+
+// Valid reports whether the sensitivity is one graph accepts.
+func (s Sensitivity) Valid() bool {
+	switch s {
+	case SensitivityNormal, SensitivityPersonal, SensitivityPrivate, SensitivityConfidential:
+		return true
+	}
+	return false
+}
+
+// Filter returns the $filter clause matching events of this sensitivity, e.g. "sensitivity eq 'private'". Messages have no
+// sensitivity property in graph, see MessageFilter.
+func (s Sensitivity) Filter() string {
+	return fmt.Sprintf("sensitivity eq '%s'", s)
+}
+
+// SensitivityPropertyID PidTagSensitivity, the MAPI property a message's sensitivity is kept in, as graph's v1.0 message has
+// no sensitivity of its own.
+const SensitivityPropertyID = "Integer 0x0036"
+
+// sensitivityValues the values of PidTagSensitivity, in the order of their integer values.
+var sensitivityValues = []Sensitivity{SensitivityNormal, SensitivityPersonal, SensitivityPrivate, SensitivityConfidential}
+
+// MessageFilter returns the $filter clause matching messages of this sensitivity, through their PidTagSensitivity property,
+// or an error if the sensitivity is not one graph knows.
+func (s Sensitivity) MessageFilter() (string, error) {
+	if !s.Valid() {
+		return "", fmt.Errorf("outlook: invalid sensitivity %q", s)
+	}
+	value := 0
+	for i, sensitivity := range sensitivityValues {
+		if sensitivity == s {
+			value = i
+		}
+	}
+	return fmt.Sprintf("singleValueExtendedProperties/Any(ep: ep/id eq '%s' and ep/value eq '%d')", SensitivityPropertyID, value), nil
+}
+
+// Sensitivity returns the message's sensitivity, read from its PidTagSensitivity property. It is only known for messages read
+// with MessageListCall.Sensitivity or MessageGetCall.ExpandSensitivity, and empty otherwise.
+func (m *Message) Sensitivity() Sensitivity {
+	value, err := strconv.Atoi(m.Property(SensitivityPropertyID))
+	if err != nil || value < 0 || value >= len(sensitivityValues) {
+		return ""
+	}
+	return sensitivityValues[value]
+}
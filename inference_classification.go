@@ -0,0 +1,116 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+)
+
+// InferenceClassificationOverrideService manages communication with microsofts graph for the overrides which always route a sender's
+// messages to the Focused or Other inbox.
+type InferenceClassificationOverrideService struct {
+	session  *Session
+	basePath string
+}
+
+// NewInferenceClassificationOverrideService returns a new instance of an InferenceClassificationOverrideService.
+func NewInferenceClassificationOverrideService(session *Session) *InferenceClassificationOverrideService {
+	return &InferenceClassificationOverrideService{
+		session:  session,
+		basePath: "/inferenceClassification/overrides",
+	}
+}
+
+// InferenceClassificationOverrideListCall struct allowing for fluent style configuration of calls to the override list endpoint.
+type InferenceClassificationOverrideListCall struct {
+	service *InferenceClassificationOverrideService
+}
+
+// List returns an InferenceClassificationOverrideListCall builder struct
+func (icos *InferenceClassificationOverrideService) List() *InferenceClassificationOverrideListCall {
+	return &InferenceClassificationOverrideListCall{service: icos}
+}
+
+// Do executes the override list call, returning the override list result.
+func (icolc *InferenceClassificationOverrideListCall) Do(ctx context.Context, opts ...RequestOption) (*InferenceClassificationOverrideListResult, error) {
+	var result InferenceClassificationOverrideListResult
+	if _, err := icolc.service.session.Get(ctx, icolc.service.basePath, nil, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// InferenceClassificationOverrideCreateCall struct allowing for fluent style configuration of calls to the override create endpoint.
+type InferenceClassificationOverrideCreateCall struct {
+	service  *InferenceClassificationOverrideService
+	override *InferenceClassificationOverride
+}
+
+// Create returns an instance of an InferenceClassificationOverrideCreateCall routing messages from sender to classifyAs,
+// one of the InferenceClassification constants. Graph replaces any existing override for the same sender.
+func (icos *InferenceClassificationOverrideService) Create(sender *EmailAddress, classifyAs string) *InferenceClassificationOverrideCreateCall {
+	return &InferenceClassificationOverrideCreateCall{
+		service: icos,
+		override: &InferenceClassificationOverride{
+			ClassifyAs:         classifyAs,
+			SenderEmailAddress: sender,
+		},
+	}
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's override.
+func (icocc *InferenceClassificationOverrideCreateCall) Do(ctx context.Context, opts ...RequestOption) (*InferenceClassificationOverride, error) {
+	if _, err := icocc.service.session.Post(ctx, icocc.service.basePath, icocc.override, icocc.override, opts...); err != nil {
+		return nil, err
+	}
+	return icocc.override, nil
+}
+
+// InferenceClassificationOverrideUpdateCall struct allowing for fluent style configuration of calls to the override update endpoint.
+type InferenceClassificationOverrideUpdateCall struct {
+	service    *InferenceClassificationOverrideService
+	overrideID string
+	classifyAs string
+}
+
+// Update returns an instance of an InferenceClassificationOverrideUpdateCall changing where the given override routes its sender's messages.
+// Only classifyAs may be updated; to change the sender, delete the override and create a new one.
+func (icos *InferenceClassificationOverrideService) Update(overrideID, classifyAs string) *InferenceClassificationOverrideUpdateCall {
+	return &InferenceClassificationOverrideUpdateCall{
+		service:    icos,
+		overrideID: overrideID,
+		classifyAs: classifyAs,
+	}
+}
+
+// Do executes the http patch request to microsoft's graph api to update the call's override.
+func (icouc *InferenceClassificationOverrideUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*InferenceClassificationOverride, error) {
+	path := fmt.Sprintf("%s/%s", icouc.service.basePath, icouc.overrideID)
+	override := &InferenceClassificationOverride{ClassifyAs: icouc.classifyAs}
+	if _, err := icouc.service.session.Patch(ctx, path, override, override, opts...); err != nil {
+		return nil, err
+	}
+	return override, nil
+}
+
+// InferenceClassificationOverrideDeleteCall struct allowing for fluent style configuration of calls to the override delete endpoint.
+type InferenceClassificationOverrideDeleteCall struct {
+	service    *InferenceClassificationOverrideService
+	overrideID string
+}
+
+// Delete returns an instance of an InferenceClassificationOverrideDeleteCall removing the given override, returning its sender to automatic classification.
+func (icos *InferenceClassificationOverrideService) Delete(overrideID string) *InferenceClassificationOverrideDeleteCall {
+	return &InferenceClassificationOverrideDeleteCall{
+		service:    icos,
+		overrideID: overrideID,
+	}
+}
+
+// Do executes the http delete request to microsoft's graph api to delete the call's override.
+func (icodc *InferenceClassificationOverrideDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", icodc.service.basePath, icodc.overrideID)
+	if _, err := icodc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
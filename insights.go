@@ -0,0 +1,178 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// InsightsService manages communication with microsofts graph for the documents trending around, used by, and shared with the user.
+type InsightsService struct {
+	session  *Session
+	basePath string
+}
+
+// NewInsightsService returns a new instance of an InsightsService.
+func NewInsightsService(session *Session) *InsightsService {
+	return &InsightsService{
+		session:  session,
+		basePath: "/insights",
+	}
+}
+
+// insightParams returns the query parameters of an insight list call.
+func insightParams(maxResults int64, nextLink string, filters []string) map[string]interface{} {
+	params := map[string]interface{}{
+		"$top": maxResults,
+	}
+	if len(filters) > 0 {
+		params["$filter"] = strings.Join(filters, " and ")
+	}
+	if nextLink != "" {
+		params["$skip"] = parsePageLink(nextLink, "$skip")
+	}
+	return params
+}
+
+func resourceTypeFilter(resourceType string) string {
+	return fmt.Sprintf("resourceVisualization/type eq '%s'", strings.ReplaceAll(resourceType, "'", "''"))
+}
+
+// TrendingListCall struct allowing for fluent style configuration of calls to the trending insights endpoint.
+type TrendingListCall struct {
+	service    *InsightsService
+	nextLink   string
+	maxResults int64
+	filters    []string
+}
+
+// Trending returns a TrendingListCall for the documents trending around the user, most relevant first.
+func (is *InsightsService) Trending() *TrendingListCall {
+	return &TrendingListCall{
+		service:    is,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the trending list call.
+func (tlc *TrendingListCall) MaxResults(pageSize int64) *TrendingListCall {
+	tlc.maxResults = pageSize
+	return tlc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the trending list call.
+func (tlc *TrendingListCall) NextLink(link string) *TrendingListCall {
+	tlc.nextLink = link
+	return tlc
+}
+
+// ResourceType limits the trending list call to documents of the given type, e.g. "PowerPoint" or "Pdf".
+func (tlc *TrendingListCall) ResourceType(resourceType string) *TrendingListCall {
+	tlc.filters = append(tlc.filters, resourceTypeFilter(resourceType))
+	return tlc
+}
+
+// Do executes the trending list call, returning the trending list result.
+func (tlc *TrendingListCall) Do(ctx context.Context, opts ...RequestOption) (*TrendingListResult, error) {
+	var result TrendingListResult
+	path := fmt.Sprintf("%s/trending", tlc.service.basePath)
+	if _, err := tlc.service.session.Get(ctx, path, insightParams(tlc.maxResults, tlc.nextLink, tlc.filters), &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UsedInsightListCall struct allowing for fluent style configuration of calls to the used insights endpoint.
+type UsedInsightListCall struct {
+	service    *InsightsService
+	nextLink   string
+	maxResults int64
+	filters    []string
+}
+
+// Used returns a UsedInsightListCall for the documents the user recently viewed or modified.
+func (is *InsightsService) Used() *UsedInsightListCall {
+	return &UsedInsightListCall{
+		service:    is,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the used list call.
+func (uilc *UsedInsightListCall) MaxResults(pageSize int64) *UsedInsightListCall {
+	uilc.maxResults = pageSize
+	return uilc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the used list call.
+func (uilc *UsedInsightListCall) NextLink(link string) *UsedInsightListCall {
+	uilc.nextLink = link
+	return uilc
+}
+
+// ResourceType limits the used list call to documents of the given type, e.g. "Word" or "Excel".
+func (uilc *UsedInsightListCall) ResourceType(resourceType string) *UsedInsightListCall {
+	uilc.filters = append(uilc.filters, resourceTypeFilter(resourceType))
+	return uilc
+}
+
+// Do executes the used list call, returning the used list result.
+func (uilc *UsedInsightListCall) Do(ctx context.Context, opts ...RequestOption) (*UsedInsightListResult, error) {
+	var result UsedInsightListResult
+	path := fmt.Sprintf("%s/used", uilc.service.basePath)
+	if _, err := uilc.service.session.Get(ctx, path, insightParams(uilc.maxResults, uilc.nextLink, uilc.filters), &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SharedInsightListCall struct allowing for fluent style configuration of calls to the shared insights endpoint.
+type SharedInsightListCall struct {
+	service    *InsightsService
+	nextLink   string
+	maxResults int64
+	filters    []string
+}
+
+// Shared returns a SharedInsightListCall for the documents shared with or by the user, most recently shared first.
+func (is *InsightsService) Shared() *SharedInsightListCall {
+	return &SharedInsightListCall{
+		service:    is,
+		maxResults: 10,
+	}
+}
+
+// MaxResults sets the $top query parameter for the shared list call.
+func (silc *SharedInsightListCall) MaxResults(pageSize int64) *SharedInsightListCall {
+	silc.maxResults = pageSize
+	return silc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the shared list call.
+func (silc *SharedInsightListCall) NextLink(link string) *SharedInsightListCall {
+	silc.nextLink = link
+	return silc
+}
+
+// ResourceType limits the shared list call to documents of the given type, e.g. "Word" or "Excel".
+func (silc *SharedInsightListCall) ResourceType(resourceType string) *SharedInsightListCall {
+	silc.filters = append(silc.filters, resourceTypeFilter(resourceType))
+	return silc
+}
+
+// SharingType limits the shared list call to documents last shared in the given way. SharingTypeAttachment gives the
+// documents shared with the user in email, whose SharingReference is the message they were attached to.
+func (silc *SharedInsightListCall) SharingType(sharingType string) *SharedInsightListCall {
+	silc.filters = append(silc.filters, fmt.Sprintf("lastShared/sharingType eq '%s'", sharingType))
+	return silc
+}
+
+// Do executes the shared list call, returning the shared list result.
+func (silc *SharedInsightListCall) Do(ctx context.Context, opts ...RequestOption) (*SharedInsightListResult, error) {
+	var result SharedInsightListResult
+	path := fmt.Sprintf("%s/shared", silc.service.basePath)
+	if _, err := silc.service.session.Get(ctx, path, insightParams(silc.maxResults, silc.nextLink, silc.filters), &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,188 @@
+package outlook
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// The interfaces below are implemented by the concrete services, so downstream code can depend on them rather than on the
+// services themselves, e.g. to wrap them with logging or caching. They are not test doubles on their own: their methods return
+// concrete calls, which only run against a Session. Tests should use a session on an outlooktest.Server, see the package doc.
+
+// Requester the raw request methods of a Session.
+type Requester interface {
+	Get(ctx context.Context, url string, params map[string]interface{}, result interface{}, opts ...RequestOption) (*http.Response, error)
+	Post(ctx context.Context, url string, data interface{}, result interface{}, opts ...RequestOption) (*http.Response, error)
+	Patch(ctx context.Context, url string, data interface{}, result interface{}, opts ...RequestOption) (*http.Response, error)
+	Delete(ctx context.Context, url string, params map[string]interface{}, result interface{}, opts ...RequestOption) (*http.Response, error)
+}
+
+// Sender sends messages, as implemented by Session.
+type Sender interface {
+	Send(ctx context.Context, message *Message, opts ...RequestOption) error
+	SendMail(message *Message) *SendMailCall
+}
+
+// CalendarServicer the methods of a CalendarService.
+type CalendarServicer interface {
+	List() *CalendarListCall
+	Get(calendarID string) *CalendarGetCall
+	GetDefault() *CalendarGetDefaultCall
+	Create() *CalendarCreateCall
+	Update(calendarID string) *CalendarUpdateCall
+	Delete(calendarID string) *CalendarDeleteCall
+	FindMeetingTimes() *FindMeetingTimesCall
+	GetSchedule(schedules []string, start, end time.Time) *GetScheduleCall
+	Permissions(calendarID string) CalendarPermissionServicer
+}
+
+// CalendarGroupServicer the methods of a CalendarGroupService.
+type CalendarGroupServicer interface {
+	List() *CalendarGroupListCall
+	Get(groupID string) *CalendarGroupGetCall
+	Create(name string) *CalendarGroupCreateCall
+	Delete(groupID string) *CalendarGroupDeleteCall
+	ListCalendars(groupID string) *CalendarGroupCalendarListCall
+	CreateCalendar(groupID string) *CalendarGroupCalendarCreateCall
+}
+
+// CalendarPermissionServicer the methods of a CalendarPermissionService.
+type CalendarPermissionServicer interface {
+	List() *CalendarPermissionListCall
+	Create(emailAddress *EmailAddress, role string) *CalendarPermissionCreateCall
+	Update(permissionID, role string) *CalendarPermissionUpdateCall
+	Delete(permissionID string) *CalendarPermissionDeleteCall
+}
+
+// ConversationServicer the methods of a ConversationService.
+type ConversationServicer interface {
+	List() *ConversationListCall
+	Get(conversationID string) *ConversationGetCall
+	Create(topic string, body *MessageBody) *ConversationCreateCall
+	Delete(conversationID string) *ConversationDeleteCall
+	ListThreads(conversationID string) *ConversationThreadListCall
+	ListPosts(threadID string) *ConversationPostListCall
+	Reply(threadID string, body *MessageBody) *ConversationReplyCall
+}
+
+// EventServicer the methods of an EventService.
+type EventServicer interface {
+	List(calendarID string) *EventListCall
+	Get(calendarID string, eventID string) *EventGetCall
+	Create(calendarID string) *EventCreateCall
+	Update(calendarID string) *EventUpdateCall
+	Delete(calendarID, eventID string) *EventDeleteCall
+	Instances(seriesMasterID string, start, end time.Time) *EventInstancesCall
+	CalendarView(start, end time.Time) *EventCalendarViewCall
+	CalendarViewDelta(start, end time.Time) *EventDeltaCall
+	ApplyInvite(invite *CalendarInvite) *EventApplyInviteCall
+	Accept(eventID string) *EventRespondCall
+	Decline(eventID string) *EventRespondCall
+	TentativelyAccept(eventID string) *EventRespondCall
+	Cancel(eventID string) *EventCancelCall
+	Forward(eventID string, recipients ...*Recipient) *EventForwardCall
+	SnoozeReminder(eventID string, newReminderTime *DateTimeTimeZone) *EventSnoozeReminderCall
+	DismissReminder(eventID string) *EventDismissReminderCall
+}
+
+// FolderServicer the methods of a FolderService.
+type FolderServicer interface {
+	List() *FolderListCall
+	Get(folderID string) *FolderGetCall
+	Create(displayName string) *FolderCreateCall
+	Update(folderID, displayName string) *FolderUpdateCall
+	Move(folderID, destinationID string) *FolderMoveCall
+	Copy(folderID, destinationID string) *FolderMoveCall
+	Delete(folderID string) *FolderDeleteCall
+	ListChildFolders(folderID string) *FolderChildListCall
+	Walk(rootID string, fn FolderWalkFunc) *FolderWalkCall
+	Stats(rootID string) *FolderStatsCall
+	Resolve(folderID string) *FolderResolveCall
+}
+
+// InferenceClassificationOverrideServicer the methods of an InferenceClassificationOverrideService.
+type InferenceClassificationOverrideServicer interface {
+	List() *InferenceClassificationOverrideListCall
+	Create(sender *EmailAddress, classifyAs string) *InferenceClassificationOverrideCreateCall
+	Update(overrideID, classifyAs string) *InferenceClassificationOverrideUpdateCall
+	Delete(overrideID string) *InferenceClassificationOverrideDeleteCall
+}
+
+// InsightsServicer the methods of an InsightsService.
+type InsightsServicer interface {
+	Trending() *TrendingListCall
+	Used() *UsedInsightListCall
+	Shared() *SharedInsightListCall
+}
+
+// MessageServicer the methods of a MessageService.
+type MessageServicer interface {
+	List(folderID string) *MessageListCall
+	Get(messageID string) *MessageGetCall
+	GetEventResponse(messageID string) *EventResponseGetCall
+	GetEventMessage(messageID string) *EventMessageGetCall
+	GetEventMessageRequest(messageID string) *EventMessageRequestGetCall
+	AcceptInvite(messageID string) *EventRespondCall
+	DeclineInvite(messageID string) *EventRespondCall
+	TentativelyAcceptInvite(messageID string) *EventRespondCall
+	GetMIME(messageID string) *MessageMIMECall
+	ListByConversation(conversationID string) *MessageConversationCall
+	Delete(messageID string) *MessageDeleteCall
+	PermanentDelete(messageID string) *MessageDeleteCall
+	EmptyFolder(folderID string) *MessageEmptyFolderCall
+	Move(messageID, destinationID string) *MessageMoveCall
+	Copy(messageID, destinationID string) *MessageMoveCall
+	Archive(messageID string) *MessageMoveCall
+	HydrateBodies(messages ...*Message) *MessageHydrateCall
+	SendDraft(messageID string) *MessageSendCall
+	MailTips(addresses ...string) *MailTipsCall
+}
+
+// PlaceServicer the methods of a PlaceService.
+type PlaceServicer interface {
+	Rooms() *RoomListCall
+	RoomLists() *RoomListListCall
+	FindRooms(ctx context.Context, criteria *RoomCriteria) ([]*Room, error)
+}
+
+// ReminderServicer the methods of a ReminderService.
+type ReminderServicer interface {
+	View(start, end time.Time) *ReminderViewCall
+}
+
+// ReportsServicer the methods of a ReportsService.
+type ReportsServicer interface {
+	EmailActivityUserDetail(period ReportPeriod) *ReportCall[EmailActivityUserDetail]
+	EmailActivityCounts(period ReportPeriod) *ReportCall[EmailActivityCounts]
+	EmailActivityUserCounts(period ReportPeriod) *ReportCall[EmailActivityCounts]
+	EmailAppUsageUserDetail(period ReportPeriod) *ReportCall[EmailAppUsageUserDetail]
+	MailboxUsageDetail(period ReportPeriod) *ReportCall[MailboxUsageDetail]
+}
+
+// SearchFolderServicer the methods of a SearchFolderService.
+type SearchFolderServicer interface {
+	List() *SearchFolderListCall
+	Get(folderID string) *SearchFolderGetCall
+	Create(displayName, filterQuery string, sourceFolderIDs ...string) *SearchFolderCreateCall
+	Update(folderID string) *SearchFolderUpdateCall
+	Delete(folderID string) *SearchFolderDeleteCall
+}
+
+var (
+	_ Requester                               = (*Session)(nil)
+	_ Sender                                  = (*Session)(nil)
+	_ CalendarServicer                        = (*CalendarService)(nil)
+	_ CalendarGroupServicer                   = (*CalendarGroupService)(nil)
+	_ CalendarPermissionServicer              = (*CalendarPermissionService)(nil)
+	_ ConversationServicer                    = (*ConversationService)(nil)
+	_ EventServicer                           = (*EventService)(nil)
+	_ FolderServicer                          = (*FolderService)(nil)
+	_ InferenceClassificationOverrideServicer = (*InferenceClassificationOverrideService)(nil)
+	_ InsightsServicer                        = (*InsightsService)(nil)
+	_ MessageServicer                         = (*MessageService)(nil)
+	_ PlaceServicer                           = (*PlaceService)(nil)
+	_ ReminderServicer                        = (*ReminderService)(nil)
+	_ ReportsServicer                         = (*ReportsService)(nil)
+	_ SearchFolderServicer                    = (*SearchFolderService)(nil)
+)
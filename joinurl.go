@@ -0,0 +1,52 @@
+package outlook
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// joinURLPatterns recognised online meeting links, in order of preference.
+	joinURLPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`https://teams\.microsoft\.com/l/meetup-join/[^\s"'<>]+`),
+		regexp.MustCompile(`https://teams\.live\.com/meet/[^\s"'<>]+`),
+		regexp.MustCompile(`https://(?:[\w-]+\.)?zoom\.us/(?:j|my|w)/[^\s"'<>]+`),
+		regexp.MustCompile(`https://(?:[\w-]+\.)?webex\.com/[^\s"'<>]*(?:j\.php|meet|join)[^\s"'<>]*`),
+		regexp.MustCompile(`https://meet\.google\.com/[a-z]{3}-[a-z]{4}-[a-z]{3}`),
+	}
+)
+
+// JoinURL returns the link used to join the event's online meeting, or an empty string if it has none.
+// The structured onlineMeeting block is preferred, then onlineMeetingUrl, then any recognised meeting link in the body.
+func (e *Event) JoinURL() string {
+	if e.OnlineMeeting != nil && e.OnlineMeeting.JoinURL != "" {
+		return e.OnlineMeeting.JoinURL
+	}
+	if e.OnlineMeetingURL != "" {
+		return e.OnlineMeetingURL
+	}
+	if e.Body != nil {
+		if joinURL := ExtractJoinURL(e.Body.Content); joinURL != "" {
+			return joinURL
+		}
+	}
+	if e.Location != nil {
+		return ExtractJoinURL(e.Location.DisplayName)
+	}
+	return ""
+}
+
+// ExtractJoinURL returns the first Teams, Zoom, Webex, or Google Meet link found in the given text or HTML, or an empty string if there is none.
+func ExtractJoinURL(content string) string {
+	if content == "" {
+		return ""
+	}
+	content = html.UnescapeString(content)
+	for _, pattern := range joinURLPatterns {
+		if match := pattern.FindString(content); match != "" {
+			return strings.TrimRight(match, ".,;)")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultMailboxConcurrency the number of requests graph allows in flight against a single mailbox before throttling with ApplicationThrottled.
+const DefaultMailboxConcurrency = 4
+
+// SetClientMailboxConcurrency returns a ClientOpt function which sets how many requests a client sends concurrently to any one mailbox,
+// queueing the rest until a slot frees up. Defaults to DefaultMailboxConcurrency; zero or less disables the limit.
+func SetClientMailboxConcurrency(limit int) ClientOpt {
+	return func(c *Client) {
+		c.mailboxLimiter = newMailboxLimiter(limit)
+	}
+}
+
+// mailboxLimiter a semaphore per mailbox, dropping a mailbox's semaphore once nothing holds or waits for it so long running daemons do not accumulate one per mailbox ever seen.
+type mailboxLimiter struct {
+	limit int
+	mu    sync.Mutex
+	slots map[string]*mailboxSlot
+}
+
+type mailboxSlot struct {
+	sem  chan struct{}
+	refs int
+}
+
+func newMailboxLimiter(limit int) *mailboxLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &mailboxLimiter{
+		limit: limit,
+		slots: map[string]*mailboxSlot{},
+	}
+}
+
+// acquire blocks until a request may be sent to the mailbox identified by key, or ctx is done. The returned func must be called once the request completes.
+func (l *mailboxLimiter) acquire(ctx context.Context, key string) (func(), error) {
+	if l == nil || key == "" {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	slot, ok := l.slots[key]
+	if !ok {
+		slot = &mailboxSlot{sem: make(chan struct{}, l.limit)}
+		l.slots[key] = slot
+	}
+	slot.refs++
+	l.mu.Unlock()
+
+	unref := func() {
+		l.mu.Lock()
+		slot.refs--
+		if slot.refs == 0 {
+			delete(l.slots, key)
+		}
+		l.mu.Unlock()
+	}
+
+	select {
+	case slot.sem <- struct{}{}:
+		return func() {
+			<-slot.sem
+			unref()
+		}, nil
+	case <-ctx.Done():
+		unref()
+		return nil, ctx.Err()
+	}
+}
+
+// mailboxKey identifies the mailbox the session's requests target. Sessions on /me are keyed by their token source as well,
+// since one client may serve sessions signed in as different users.
+func (session *Session) mailboxKey() string {
+	switch session.basePath {
+	case "", "/":
+		return ""
+	case "/me":
+		return fmt.Sprintf("/me#%p", session.tokenSource)
+	}
+	return session.basePath
+}
+
+// SetClientRateLimit returns a ClientOpt function which limits the client, across every session using it, to requestsPerSecond
+// with bursts of up to burst requests. Requests beyond the limit wait for a token rather than failing, so tenant-wide daemons
+// stay under graph's service limits instead of backing off after being throttled.
+func SetClientRateLimit(requestsPerSecond float64, burst int) ClientOpt {
+	return func(c *Client) {
+		c.rateLimiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+}
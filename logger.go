@@ -0,0 +1,103 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LogLevel the severity of a log entry.
+type LogLevel int
+
+const (
+	// LogLevelDebug verbose entries, e.g. every successful request
+	LogLevelDebug LogLevel = iota
+	// LogLevelInfo notable but expected entries
+	LogLevelInfo
+	// LogLevelWarn requests microsoft rejected, e.g. throttled or not found
+	LogLevelWarn
+	// LogLevelError requests which failed to complete at all
+	LogLevelError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LogLevel(%d)", int(l))
+}
+
+// Logger receives leveled, structured log entries from a Client. keyvals alternate between string keys and values,
+// matching the convention of log/slog and most structured logging libraries, so adapters are a one liner.
+type Logger interface {
+	Log(ctx context.Context, level LogLevel, msg string, keyvals ...interface{})
+}
+
+// LoggerFunc an adapter allowing an ordinary function to be used as a Logger.
+type LoggerFunc func(ctx context.Context, level LogLevel, msg string, keyvals ...interface{})
+
+// Log calls f(ctx, level, msg, keyvals...).
+func (f LoggerFunc) Log(ctx context.Context, level LogLevel, msg string, keyvals ...interface{}) {
+	f(ctx, level, msg, keyvals...)
+}
+
+// SetClientLogger returns a ClientOpt function which sets the logger the client reports every request to. Logging is disabled by default.
+func SetClientLogger(logger Logger) ClientOpt {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// NewStdLogger returns a Logger writing entries at or above minLevel to l as "LEVEL msg key=value ...".
+func NewStdLogger(l *log.Logger, minLevel LogLevel) Logger {
+	return LoggerFunc(func(ctx context.Context, level LogLevel, msg string, keyvals ...interface{}) {
+		if level < minLevel {
+			return
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "%s %s", level, msg)
+		for i := 0; i+1 < len(keyvals); i += 2 {
+			fmt.Fprintf(&b, " %v=%v", keyvals[i], keyvals[i+1])
+		}
+		l.Print(b.String())
+	})
+}
+
+// logRequest reports the outcome of a single round trip to the client's logger, if any.
+// Only the url path is logged since query strings may contain search terms or other personal data.
+func (client *Client) logRequest(req *http.Request, res *http.Response, err error, elapsed time.Duration) {
+	if client.logger == nil {
+		return
+	}
+
+	keyvals := []interface{}{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"duration", elapsed,
+		"client-request-id", req.Header.Get("client-request-id"),
+	}
+	if err != nil {
+		client.logger.Log(req.Context(), LogLevelError, "graph request failed", append(keyvals, "error", err)...)
+		return
+	}
+
+	keyvals = append(keyvals, "status", res.StatusCode, "request-id", res.Header.Get("request-id"))
+	level := LogLevelDebug
+	if res.StatusCode >= 400 {
+		level = LogLevelWarn
+	}
+	if retryAfter := res.Header.Get("Retry-After"); retryAfter != "" {
+		keyvals = append(keyvals, "retry-after", retryAfter)
+	}
+	client.logger.Log(req.Context(), level, "graph request", keyvals...)
+}
@@ -0,0 +1,128 @@
+package outlook
+
+import (
+	"context"
+	"strings"
+)
+
+// MailTipsType enum, the mail tips requested by a MailTipsCall
+const (
+	MailTipsTypeAutomaticReplies     = "automaticReplies"
+	MailTipsTypeMailboxFullStatus    = "mailboxFullStatus"
+	MailTipsTypeCustomMailTip        = "customMailTip"
+	MailTipsTypeExternalMemberCount  = "externalMemberCount"
+	MailTipsTypeTotalMemberCount     = "totalMemberCount"
+	MailTipsTypeMaxMessageSize       = "maxMessageSize"
+	MailTipsTypeDeliveryRestriction  = "deliveryRestriction"
+	MailTipsTypeModerationStatus     = "moderationStatus"
+	MailTipsTypeRecipientScope       = "recipientScope"
+	MailTipsTypeRecipientSuggestions = "recipientSuggestions"
+)
+
+// RecipientScope enum, graph reports a recipient's scope as a comma separated combination of these
+const (
+	RecipientScopeNone               = "none"
+	RecipientScopeInternal           = "internal"
+	RecipientScopeExternal           = "external"
+	RecipientScopeExternalPartner    = "externalPartner"
+	RecipientScopeExternalNonPartner = "externalNonPartner"
+)
+
+// DefaultMailTipsTypes the mail tips requested when a MailTipsCall does not set its own, those a compose window typically warns about.
+var DefaultMailTipsTypes = []string{
+	MailTipsTypeAutomaticReplies,
+	MailTipsTypeMailboxFullStatus,
+	MailTipsTypeCustomMailTip,
+	MailTipsTypeExternalMemberCount,
+	MailTipsTypeMaxMessageSize,
+	MailTipsTypeRecipientScope,
+}
+
+// MailTips microsoft mailTips object, informational messages about a recipient shown to users while they compose a message.
+// Only the tips which were requested are set.
+type MailTips struct {
+	EmailAddress         *EmailAddress        `json:"emailAddress,omitempty"`
+	AutomaticReplies     *AutomaticRepliesTip `json:"automaticReplies,omitempty"`
+	MailboxFull          bool                 `json:"mailboxFull,omitempty"`
+	CustomMailTip        string               `json:"customMailTip,omitempty"`
+	ExternalMemberCount  int                  `json:"externalMemberCount,omitempty"`
+	TotalMemberCount     int                  `json:"totalMemberCount,omitempty"`
+	MaxMessageSize       int                  `json:"maxMessageSize,omitempty"`
+	DeliveryRestricted   bool                 `json:"deliveryRestricted,omitempty"`
+	IsModerated          bool                 `json:"isModerated,omitempty"`
+	RecipientScope       string               `json:"recipientScope,omitempty"`
+	RecipientSuggestions []*Recipient         `json:"recipientSuggestions,omitempty"`
+	Error                *MailTipsError       `json:"error,omitempty"`
+}
+
+// AutomaticRepliesTip microsoft automaticRepliesMailTips object, a recipient's automatic reply, e.g. an out of office message.
+type AutomaticRepliesTip struct {
+	Message            string            `json:"message,omitempty"`
+	MessageLanguage    *LocaleInfo       `json:"messageLanguage,omitempty"`
+	ScheduledStartTime *DateTimeTimeZone `json:"scheduledStartTime,omitempty"`
+	ScheduledEndTime   *DateTimeTimeZone `json:"scheduledEndTime,omitempty"`
+}
+
+// LocaleInfo microsoft localeInfo object
+type LocaleInfo struct {
+	Locale      string `json:"locale,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// MailTipsError microsoft mailTipsError object, set when graph could not get a recipient's mail tips.
+type MailTipsError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// HasAutomaticReply reports whether the recipient has an automatic reply turned on.
+func (mt *MailTips) HasAutomaticReply() bool {
+	return mt.AutomaticReplies != nil && mt.AutomaticReplies.Message != ""
+}
+
+// IsExternal reports whether the recipient is outside the sender's organization, which requires MailTipsTypeRecipientScope.
+func (mt *MailTips) IsExternal() bool {
+	for _, scope := range strings.Split(mt.RecipientScope, ",") {
+		if strings.HasPrefix(strings.TrimSpace(scope), RecipientScopeExternal) {
+			return true
+		}
+	}
+	return false
+}
+
+// MailTipsCall struct allowing for fluent style configuration of calls to the getMailTips endpoint.
+type MailTipsCall struct {
+	service   *MessageService
+	addresses []string
+	types     []string
+}
+
+// MailTips returns an instance of a MailTipsCall getting the mail tips of the given recipient addresses, as seen by the session's mailbox.
+func (ms *MessageService) MailTips(addresses ...string) *MailTipsCall {
+	return &MailTipsCall{
+		service:   ms,
+		addresses: addresses,
+		types:     DefaultMailTipsTypes,
+	}
+}
+
+// Types sets the mail tips requested, any of the MailTipsType constants, replacing DefaultMailTipsTypes.
+func (mtc *MailTipsCall) Types(types ...string) *MailTipsCall {
+	mtc.types = types
+	return mtc
+}
+
+// Do executes the http post request to microsoft's graph api, returning the mail tips of each recipient in the order they were given.
+func (mtc *MailTipsCall) Do(ctx context.Context, opts ...RequestOption) ([]*MailTips, error) {
+	body := map[string]interface{}{
+		"EmailAddresses":  mtc.addresses,
+		"MailTipsOptions": strings.Join(mtc.types, ", "),
+	}
+	var result struct {
+		Value []*MailTips `json:"value,omitempty"`
+	}
+	if _, err := mtc.service.session.Post(ctx, "/getMailTips", body, &result, opts...); err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
@@ -0,0 +1,463 @@
+package outlook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// MailboxExportFormat how a MailboxExporter writes messages.
+type MailboxExportFormat string
+
+const (
+	// MailboxExportMbox writes each folder as a single mboxrd file, e.g. Inbox/Projects.mbox
+	MailboxExportMbox MailboxExportFormat = "mbox"
+	// MailboxExportEML writes each message as its own .eml file in a directory per folder, e.g. Inbox/Projects/<hash>.eml
+	MailboxExportEML MailboxExportFormat = "eml"
+)
+
+const (
+	// MailboxExportManifest the name of the manifest a MailboxExporter keeps in its directory, one json ExportedMessage per line
+	MailboxExportManifest = "manifest.jsonl"
+	// DefaultMailboxExportPageSize the number of messages a MailboxExporter lists per page by default
+	DefaultMailboxExportPageSize = 100
+)
+
+// ExportedMessage a manifest entry recording where a MailboxExporter wrote a message.
+type ExportedMessage struct {
+	ID                string `json:"id"`
+	FolderID          string `json:"folderId"`
+	FolderPath        string `json:"folderPath"`
+	InternetMessageID string `json:"internetMessageId,omitempty"`
+	Subject           string `json:"subject,omitempty"`
+	ReceivedOn        string `json:"receivedDateTime,omitempty"`
+	// File the file the message was written to, relative to the export directory.
+	File string `json:"file"`
+	// Offset where the message starts in an mbox file, always 0 for eml files.
+	Offset int64 `json:"offset"`
+	// Size the number of bytes written for the message, including its mbox From line.
+	Size int64 `json:"size"`
+}
+
+// MailboxExportResult the outcome of a MailboxExporter's Export.
+type MailboxExportResult struct {
+	Folders int
+	// Exported the number of messages written by this export.
+	Exported int
+	// Skipped the number of messages already in the manifest from an earlier, interrupted export.
+	Skipped int
+}
+
+// MailboxExportOpt functions to configure options on a MailboxExporter.
+type MailboxExportOpt func(*MailboxExporter)
+
+// SetMailboxExportFormat returns a MailboxExportOpt which sets how messages are written. Defaults to MailboxExportMbox.
+func SetMailboxExportFormat(format MailboxExportFormat) MailboxExportOpt {
+	return func(me *MailboxExporter) {
+		me.format = format
+	}
+}
+
+// SetMailboxExportFolders returns a MailboxExportOpt which selects the folders exported, by id or well known name, each
+// along with its child folders. Defaults to every folder in the mailbox.
+func SetMailboxExportFolders(folderIDs ...string) MailboxExportOpt {
+	return func(me *MailboxExporter) {
+		me.folderIDs = folderIDs
+	}
+}
+
+// SetMailboxExportIncludeHidden returns a MailboxExportOpt which sets whether hidden folders are exported.
+func SetMailboxExportIncludeHidden(include bool) MailboxExportOpt {
+	return func(me *MailboxExporter) {
+		me.includeHidden = include
+	}
+}
+
+// SetMailboxExportPageSize returns a MailboxExportOpt which sets the number of messages listed per page.
+func SetMailboxExportPageSize(pageSize int64) MailboxExportOpt {
+	return func(me *MailboxExporter) {
+		me.pageSize = pageSize
+	}
+}
+
+// SetMailboxExportProgress returns a MailboxExportOpt which sets a function called after each message is written.
+func SetMailboxExportProgress(fn func(*ExportedMessage)) MailboxExportOpt {
+	return func(me *MailboxExporter) {
+		me.progress = fn
+	}
+}
+
+// MailboxExporter writes the messages of a mailbox to a directory, as their original MIME content, for backup and compliance.
+// Every message written is recorded in the directory's manifest, so an interrupted export can be resumed by running it
+// again on the same directory: messages already in the manifest are skipped, and mbox files are cut back to the end of
+// the last message the manifest records, dropping any message written only partially.
+//
+// Messages are written one at a time, in the order graph lists them, so mbox files stay in a consistent order.
+type MailboxExporter struct {
+	session       *Session
+	dir           string
+	format        MailboxExportFormat
+	folderIDs     []string
+	includeHidden bool
+	pageSize      int64
+	progress      func(*ExportedMessage)
+
+	manifest *os.File
+	exported map[string]bool
+	// ends where the last message recorded in each mbox file ends, keyed by file.
+	ends  map[string]int64
+	files map[string]*os.File
+}
+
+// NewMailboxExporter returns a new instance of a MailboxExporter writing the session's mailbox to dir, which is created if needed.
+func NewMailboxExporter(session *Session, dir string, opts ...MailboxExportOpt) *MailboxExporter {
+	me := &MailboxExporter{
+		session:  session,
+		dir:      dir,
+		format:   MailboxExportMbox,
+		pageSize: DefaultMailboxExportPageSize,
+	}
+	for _, opt := range opts {
+		opt(me)
+	}
+	return me
+}
+
+// Export writes every message of the selected folders which is not in the manifest yet.
+func (me *MailboxExporter) Export(ctx context.Context) (*MailboxExportResult, error) {
+	if me.format != MailboxExportMbox && me.format != MailboxExportEML {
+		return nil, fmt.Errorf("export: unknown format %q", me.format)
+	}
+	if err := os.MkdirAll(me.dir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := me.openManifest(); err != nil {
+		return nil, err
+	}
+	me.files = map[string]*os.File{}
+	defer me.close()
+
+	result := &MailboxExportResult{}
+	visit := func(folder *Folder, folderPath string) error {
+		result.Folders++
+		return me.exportFolder(ctx, folder, folderPath, result)
+	}
+
+	if len(me.folderIDs) == 0 {
+		if err := me.walk(ctx, "", nil, visit); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+	for _, folderID := range me.folderIDs {
+		folder, err := me.session.Folders().Get(folderID).Do(ctx)
+		if err != nil {
+			return result, err
+		}
+		path := []string{exportFileName(folder.DisplayName)}
+		if err := visit(folder, filepath.Join(path...)); err != nil {
+			return result, err
+		}
+		if err := me.walk(ctx, folder.ID, path, visit); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// walk visits the folders below rootID with their path, relative to the export directory.
+func (me *MailboxExporter) walk(ctx context.Context, rootID string, rootPath []string, visit func(*Folder, string) error) error {
+	path := append([]string(nil), rootPath...)
+	return me.session.Folders().Walk(rootID, func(folder *Folder, depth int) error {
+		path = append(path[:len(rootPath)+depth], exportFileName(folder.DisplayName))
+		return visit(folder, filepath.Join(path...))
+	}).IncludeHidden(me.includeHidden).Do(ctx)
+}
+
+func (me *MailboxExporter) exportFolder(ctx context.Context, folder *Folder, folderPath string, result *MailboxExportResult) error {
+	nextLink := ""
+	for {
+		page, err := me.session.Messages().List(folder.ID).MaxResults(me.pageSize).NextLink(nextLink).DoResponse(ctx)
+		if err != nil {
+			return err
+		}
+		for _, message := range page.Items {
+			if me.exported[message.ID] {
+				result.Skipped++
+				continue
+			}
+			entry := &ExportedMessage{
+				ID:                message.ID,
+				FolderID:          folder.ID,
+				FolderPath:        filepath.ToSlash(folderPath),
+				InternetMessageID: message.MessageID,
+				Subject:           message.Subject,
+				ReceivedOn:        message.ReceivedOn,
+			}
+			if err := me.exportMessage(ctx, entry, message); err != nil {
+				return fmt.Errorf("export: message %s in %s: %w", message.ID, entry.FolderPath, err)
+			}
+			if err := me.record(entry); err != nil {
+				return err
+			}
+			result.Exported++
+			if me.progress != nil {
+				me.progress(entry)
+			}
+		}
+		if !page.HasMore() {
+			return nil
+		}
+		nextLink = page.NextLink
+	}
+}
+
+func (me *MailboxExporter) exportMessage(ctx context.Context, entry *ExportedMessage, message *Message) error {
+	if me.format == MailboxExportEML {
+		sum := sha256.Sum256([]byte(message.ID))
+		entry.File = filepath.ToSlash(filepath.Join(entry.FolderPath, hex.EncodeToString(sum[:16])+".eml"))
+		return me.writeEML(ctx, entry)
+	}
+	entry.File = entry.FolderPath + ".mbox"
+	return me.writeMbox(ctx, entry, message)
+}
+
+// writeEML writes the message to a temporary file renamed into place once complete, so no partial .eml is left behind.
+func (me *MailboxExporter) writeEML(ctx context.Context, entry *ExportedMessage) error {
+	name := filepath.Join(me.dir, filepath.FromSlash(entry.File))
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(name), ".export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	counter := &countingWriter{w: f}
+	err = me.session.Messages().GetMIME(entry.ID).Stream(ctx, counter)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	entry.Size = counter.n
+	return os.Rename(f.Name(), name)
+}
+
+func (me *MailboxExporter) writeMbox(ctx context.Context, entry *ExportedMessage, message *Message) error {
+	f, err := me.mboxFile(entry.File)
+	if err != nil {
+		return err
+	}
+	offset := me.ends[entry.File]
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	counter := &countingWriter{w: f}
+	buffered := bufio.NewWriter(counter)
+	received := time.Now().UTC()
+	if t, err := time.Parse(time.RFC3339, message.ReceivedOn); err == nil {
+		received = t.UTC()
+	}
+	if _, err := fmt.Fprintf(buffered, "From MAILER-DAEMON %s\n", received.Format(time.ANSIC)); err != nil {
+		return err
+	}
+	mw := &mboxWriter{w: buffered}
+	if err := me.session.Messages().GetMIME(entry.ID).Stream(ctx, mw); err != nil {
+		return err
+	}
+	if err := mw.Close(); err != nil {
+		return err
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+
+	entry.Offset = offset
+	entry.Size = counter.n
+	me.ends[entry.File] = offset + counter.n
+	return nil
+}
+
+// mboxFile opens an mbox file of the export, cutting it back to the end of the last message the manifest records.
+func (me *MailboxExporter) mboxFile(file string) (*os.File, error) {
+	if f, ok := me.files[file]; ok {
+		return f, nil
+	}
+	name := filepath.Join(me.dir, filepath.FromSlash(file))
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Truncate(me.ends[file]); err != nil {
+		f.Close()
+		return nil, err
+	}
+	me.files[file] = f
+	return f, nil
+}
+
+// openManifest reads the manifest of an earlier export, if any, and opens it for appending.
+func (me *MailboxExporter) openManifest() error {
+	me.exported = map[string]bool{}
+	me.ends = map[string]int64{}
+
+	name := filepath.Join(me.dir, MailboxExportManifest)
+	data, err := os.ReadFile(name)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	// A line cut short by an interrupted write is dropped, along with the message it recorded, which is exported again.
+	complete := bytes.LastIndexByte(data, '\n') + 1
+	for _, line := range bytes.Split(data[:complete], []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var entry ExportedMessage
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("export: invalid manifest %s: %w", name, err)
+		}
+		if format := exportFileFormat(entry.File); format != me.format {
+			return fmt.Errorf("export: %s holds a %s export, not %s", me.dir, format, me.format)
+		}
+		me.exported[entry.ID] = true
+		if end := entry.Offset + entry.Size; end > me.ends[entry.File] {
+			me.ends[entry.File] = end
+		}
+	}
+
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(int64(complete)); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		f.Close()
+		return err
+	}
+	me.manifest = f
+	return nil
+}
+
+// record appends entry to the manifest, once its message is completely written.
+func (me *MailboxExporter) record(entry *ExportedMessage) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := me.manifest.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	me.exported[entry.ID] = true
+	return nil
+}
+
+func (me *MailboxExporter) close() {
+	for _, f := range me.files {
+		f.Close()
+	}
+	if me.manifest != nil {
+		me.manifest.Close()
+		me.manifest = nil
+	}
+}
+
+func exportFileFormat(file string) MailboxExportFormat {
+	if strings.HasSuffix(file, ".eml") {
+		return MailboxExportEML
+	}
+	return MailboxExportMbox
+}
+
+// exportFileName makes a folder's display name safe to use as a file name.
+func exportFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return name
+}
+
+// mboxWriter writes a MIME message in mboxrd form: lines end in LF, and lines starting with any number of '>' followed
+// by "From " get one more '>', so they can't be mistaken for the From line starting the next message.
+type mboxWriter struct {
+	w    io.Writer
+	line []byte
+}
+
+func (mw *mboxWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			mw.line = append(mw.line, p...)
+			break
+		}
+		mw.line = append(mw.line, p[:i]...)
+		p = p[i+1:]
+		if err := mw.flushLine(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (mw *mboxWriter) flushLine() error {
+	line := bytes.TrimSuffix(mw.line, []byte("\r"))
+	if bytes.HasPrefix(bytes.TrimLeft(line, ">"), []byte("From ")) {
+		if _, err := mw.w.Write([]byte(">")); err != nil {
+			return err
+		}
+	}
+	if _, err := mw.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	mw.line = mw.line[:0]
+	return nil
+}
+
+// Close writes the last line, if it has no line ending, and the blank line ending the message.
+func (mw *mboxWriter) Close() error {
+	if len(mw.line) > 0 {
+		if err := mw.flushLine(); err != nil {
+			return err
+		}
+	}
+	_, err := mw.w.Write([]byte("\n"))
+	return err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
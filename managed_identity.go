@@ -0,0 +1,111 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultIMDSEndpoint the azure instance metadata service endpoint used to acquire managed identity tokens on virtual machines
+	DefaultIMDSEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+	// DefaultGraphResource the resource identifier managed identity tokens are requested for
+	DefaultGraphResource = "https://graph.microsoft.com"
+)
+
+// ManagedIdentityOpt functions to configure a managed identity TokenSource.
+type ManagedIdentityOpt func(*managedIdentityTokenSource)
+
+// SetManagedIdentityClientID returns a ManagedIdentityOpt which selects a user-assigned managed identity by its client id.
+func SetManagedIdentityClientID(clientID string) ManagedIdentityOpt {
+	return func(mits *managedIdentityTokenSource) {
+		mits.clientID = clientID
+	}
+}
+
+// SetManagedIdentityResource returns a ManagedIdentityOpt which sets the resource tokens are requested for. Defaults to DefaultGraphResource.
+func SetManagedIdentityResource(resource string) ManagedIdentityOpt {
+	return func(mits *managedIdentityTokenSource) {
+		mits.resource = resource
+	}
+}
+
+type managedIdentityTokenSource struct {
+	ctx      context.Context
+	clientID string
+	resource string
+}
+
+// NewManagedIdentityTokenSource returns a TokenSource backed by the azure managed identity available to the current process.
+// Workload identity federation (AKS) is used when AZURE_FEDERATED_TOKEN_FILE is set, the App Service identity endpoint when IDENTITY_ENDPOINT is set,
+// and the instance metadata service otherwise. Tokens are app-only, so sessions must target a user with Client.NewSessionForUser.
+func NewManagedIdentityTokenSource(ctx context.Context, opts ...ManagedIdentityOpt) oauth2.TokenSource {
+	mits := &managedIdentityTokenSource{
+		ctx:      ctx,
+		resource: DefaultGraphResource,
+	}
+	for _, opt := range opts {
+		opt(mits)
+	}
+
+	if tokenFile := os.Getenv("AZURE_FEDERATED_TOKEN_FILE"); tokenFile != "" {
+		clientID := mits.clientID
+		if clientID == "" {
+			clientID = os.Getenv("AZURE_CLIENT_ID")
+		}
+		authority := Authority{Host: os.Getenv("AZURE_AUTHORITY_HOST"), Tenant: os.Getenv("AZURE_TENANT_ID")}
+		if authority.Host == "" {
+			authority.Host = DefaultAuthorityHost
+		}
+		return NewReuseTokenSource(nil, &assertionTokenSource{
+			ctx:       ctx,
+			tokenURL:  authority.TokenURL(),
+			clientID:  clientID,
+			scope:     strings.TrimSuffix(mits.resource, "/") + "/.default",
+			assertion: AssertionFromFile(tokenFile),
+		})
+	}
+
+	return NewReuseTokenSource(nil, mits)
+}
+
+func (mits *managedIdentityTokenSource) Token() (*oauth2.Token, error) {
+	return mits.TokenContext(mits.ctx)
+}
+
+func (mits *managedIdentityTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	query := url.Values{"resource": {mits.resource}}
+
+	var endpoint string
+	header := http.Header{}
+	if identityEndpoint := os.Getenv("IDENTITY_ENDPOINT"); identityEndpoint != "" {
+		endpoint = identityEndpoint
+		query.Set("api-version", "2019-08-01")
+		header.Set("X-IDENTITY-HEADER", os.Getenv("IDENTITY_HEADER"))
+	} else {
+		endpoint = DefaultIMDSEndpoint
+		query.Set("api-version", "2018-02-01")
+		header.Set("Metadata", "true")
+	}
+	if mits.clientID != "" {
+		query.Set("client_id", mits.clientID)
+	}
+
+	ctx = withTokenHTTPClient(ctx, mits.ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header
+
+	token, err := retrieveToken(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("managed identity: %w", err)
+	}
+	return token, nil
+}
@@ -3,9 +3,82 @@ package outlook
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
+var (
+	// DefaultMessageFields the fields of a Message requested from microsoft's graph api when a call selects fields graph
+	// does not return by default, such as uniqueBody, on top of them. Sessions on the beta api also request mentionsPreview.
+	DefaultMessageFields = strings.Join([]string{
+		"id",
+		"internetMessageId",
+		"createdDateTime",
+		"receivedDateTime",
+		"sentDateTime",
+		"subject",
+		"bodyPreview",
+		"importance",
+		"conversationId",
+		"isRead",
+		"body",
+		"sender",
+		"from",
+		"toRecipients",
+		"ccRecipients",
+		"bccRecipients",
+		"replyTo",
+		"hasAttachments",
+		"inferenceClassification",
+		"isReadReceiptRequested",
+		"isDeliveryReceiptRequested",
+	}, ",")
+)
+
+// messageSelect which of a message's fields a call selects, on top of or out of DefaultMessageFields.
+type messageSelect int
+
+const (
+	// messageSelectDefault leaves $select unset, so graph returns its default fields.
+	messageSelectDefault messageSelect = iota
+	messageSelectUniqueBody
+	messageSelectBodyPreview
+	messageSelectOmitBody
+)
+
+// param returns the $select query parameter reading messages through session, or empty for messageSelectDefault. It is built
+// from DefaultMessageFields when the call is made, so changes to it apply to calls made afterwards.
+func (ms messageSelect) param(session *Session) string {
+	if ms == messageSelectDefault {
+		return ""
+	}
+
+	var omitted []string
+	switch ms {
+	case messageSelectBodyPreview:
+		omitted = []string{"body"}
+	case messageSelectOmitBody:
+		omitted = []string{"body", "bodyPreview"}
+	}
+	var fields []string
+	for _, field := range strings.Split(DefaultMessageFields, ",") {
+		if field != "" && !containsString(omitted, field) {
+			fields = append(fields, field)
+		}
+	}
+	// mentionsPreview is only known to the beta api, v1.0 rejects selecting it.
+	if session.usesBeta() && !containsString(fields, "mentionsPreview") {
+		fields = append(fields, "mentionsPreview")
+	}
+	if ms == messageSelectUniqueBody {
+		fields = append(fields, "uniqueBody")
+	}
+	return strings.Join(fields, ",")
+}
+
 // MessageService manages communication with microsofts graph for message resources.
 type MessageService struct {
 	session  *Session
@@ -28,6 +101,11 @@ type MessageListCall struct {
 	maxResults int64
 	startTime  time.Time
 	endTime    time.Time
+	filters    []string
+	mentioned  bool
+	properties []string
+	selects    messageSelect
+	err        error
 }
 
 // List returns a MessageListCall builder struct
@@ -63,24 +141,696 @@ func (mlc *MessageListCall) EndTime(end time.Time) *MessageListCall {
 	return mlc
 }
 
+// InferenceClassification limits the message list call to messages in the Focused or Other inbox, one of the InferenceClassification constants.
+func (mlc *MessageListCall) InferenceClassification(classification string) *MessageListCall {
+	mlc.filters = append(mlc.filters, fmt.Sprintf("inferenceClassification eq '%s'", classification))
+	return mlc
+}
+
+// Mentioned limits the message list call to messages in which the signed in user is @mentioned. The filter is only supported
+// by graph's beta api, see Session.WithAPIVersion; on other sessions the call fails with ErrBetaOnly.
+func (mlc *MessageListCall) Mentioned() *MessageListCall {
+	mlc.filters = append(mlc.filters, "mentionsPreview/isMentioned eq true")
+	mlc.mentioned = true
+	return mlc
+}
+
+// Importance limits the message list call to messages of the given importance.
+func (mlc *MessageListCall) Importance(importance Importance) *MessageListCall {
+	mlc.filters = append(mlc.filters, importance.Filter())
+	return mlc
+}
+
+// Sensitivity limits the message list call to messages of the given sensitivity, filtering on their PidTagSensitivity
+// property, which is returned along with them for Message.Sensitivity. An invalid sensitivity fails the call.
+func (mlc *MessageListCall) Sensitivity(sensitivity Sensitivity) *MessageListCall {
+	filter, err := sensitivity.MessageFilter()
+	if err != nil {
+		if mlc.err == nil {
+			mlc.err = err
+		}
+		return mlc
+	}
+	mlc.filters = append(mlc.filters, filter)
+	mlc.properties = appendMissing(mlc.properties, SensitivityPropertyID)
+	return mlc
+}
+
+// UniqueBody sets the $select query parameter so messages are returned with their uniqueBody, the newest part of their
+// reply chain, which is what summarization and ticketing integrations usually want to ingest.
+func (mlc *MessageListCall) UniqueBody() *MessageListCall {
+	mlc.selects = messageSelectUniqueBody
+	return mlc
+}
+
+// BodyPreviewOnly sets the $select query parameter so messages are returned with their bodyPreview, the first 255
+// characters of the body, but without the body itself, which keeps listings for inbox screens small. Bodies can be read
+// later with MessageService.HydrateBodies.
+func (mlc *MessageListCall) BodyPreviewOnly() *MessageListCall {
+	mlc.selects = messageSelectBodyPreview
+	return mlc
+}
+
+// OmitBody sets the $select query parameter so messages are returned without their body or bodyPreview.
+func (mlc *MessageListCall) OmitBody() *MessageListCall {
+	mlc.selects = messageSelectOmitBody
+	return mlc
+}
+
+// Receipts limits the message list call to read and delivery receipts, returned with the properties Message.Receipt
+// parses them from.
+func (mlc *MessageListCall) Receipts() *MessageListCall {
+	mlc.filters = append(mlc.filters, receiptFilter)
+	mlc.properties = appendMissing(mlc.properties, receiptProperties...)
+	return mlc
+}
+
 // Do executes the message list call, returning the message list result.
-func (mlc *MessageListCall) Do(ctx context.Context) (*MessageListResult, error) {
+func (mlc *MessageListCall) Do(ctx context.Context, opts ...RequestOption) (*MessageListResult, error) {
+	var result MessageListResult
+	if _, err := mlc.do(ctx, &result, opts); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DoResponse executes the message list call, returning the page along with its paging and correlation metadata.
+func (mlc *MessageListCall) DoResponse(ctx context.Context, opts ...RequestOption) (*Response[*Message], error) {
+	var page odataCollection[*Message]
+	res, err := mlc.do(ctx, &page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(&page, res), nil
+}
+
+func (mlc *MessageListCall) do(ctx context.Context, result interface{}, opts []RequestOption) (*http.Response, error) {
+	if mlc.err != nil {
+		return nil, mlc.err
+	}
+	if mlc.mentioned && !mlc.service.session.usesBeta() {
+		return nil, fmt.Errorf("mentioned filter: %w", ErrBetaOnly)
+	}
 	params := map[string]interface{}{
 		"$top":          mlc.maxResults,
 		"$count":        true,
 		"startDateTime": mlc.startTime.Format(DefaultQueryDateTimeFormat),
 		"endDateTime":   mlc.endTime.Format(DefaultQueryDateTimeFormat),
 	}
+	if len(mlc.filters) > 0 {
+		params["$filter"] = strings.Join(mlc.filters, " and ")
+	}
+	if len(mlc.properties) > 0 {
+		params["$expand"] = singleValuePropertiesExpand(mlc.properties)
+	}
+	if selects := mlc.selects.param(mlc.service.session); selects != "" {
+		params["$select"] = selects
+	}
 	if mlc.nextLink != "" {
 		params["$skip"] = parsePageLink(mlc.nextLink, "$skip")
 	}
 
 	path := fmt.Sprintf("/mailFolders/%s%s", mlc.folderID, mlc.service.basePath)
 
-	var result MessageListResult
-	if _, err := mlc.service.session.Get(ctx, path, params, &result); err != nil {
+	return mlc.service.session.Get(ctx, path, params, result, opts...)
+}
+
+// MessageGetCall struct allowing for fluent style configuration of calls to the message get endpoint.
+type MessageGetCall struct {
+	service    *MessageService
+	messageID  string
+	mentions   bool
+	properties []string
+	selects    messageSelect
+}
+
+// Get returns an instance of a MessageGetCall with the given messageID.
+func (ms *MessageService) Get(messageID string) *MessageGetCall {
+	return &MessageGetCall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// ExpandMentions sets the $expand query parameter so the message is returned with its @mentions.
+// Mentions are only supported by the beta api, see Session.WithAPIVersion; on other sessions the call fails with ErrBetaOnly.
+func (mgc *MessageGetCall) ExpandMentions() *MessageGetCall {
+	mgc.mentions = true
+	return mgc
+}
+
+// ExpandReceipt sets the $expand query parameter so the message is returned with the properties Message.Receipt parses
+// a read or delivery receipt from.
+func (mgc *MessageGetCall) ExpandReceipt() *MessageGetCall {
+	mgc.properties = appendMissing(mgc.properties, receiptProperties...)
+	return mgc
+}
+
+// ExpandSensitivity sets the $expand query parameter so the message is returned with the property Message.Sensitivity reads.
+func (mgc *MessageGetCall) ExpandSensitivity() *MessageGetCall {
+	mgc.properties = appendMissing(mgc.properties, SensitivityPropertyID)
+	return mgc
+}
+
+// UniqueBody sets the $select query parameter so the message is returned with its uniqueBody, the newest part of its reply chain.
+func (mgc *MessageGetCall) UniqueBody() *MessageGetCall {
+	mgc.selects = messageSelectUniqueBody
+	return mgc
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's message.
+func (mgc *MessageGetCall) Do(ctx context.Context, opts ...RequestOption) (*Message, error) {
+	if mgc.mentions && !mgc.service.session.usesBeta() {
+		return nil, fmt.Errorf("mentions: %w", ErrBetaOnly)
+	}
+	path := fmt.Sprintf("%s/%s", mgc.service.basePath, mgc.messageID)
+	params := map[string]interface{}{}
+	var expand []string
+	if mgc.mentions {
+		expand = append(expand, "mentions")
+	}
+	if len(mgc.properties) > 0 {
+		expand = append(expand, singleValuePropertiesExpand(mgc.properties))
+	}
+	if len(expand) > 0 {
+		params["$expand"] = strings.Join(expand, ",")
+	}
+	if selects := mgc.selects.param(mgc.service.session); selects != "" {
+		params["$select"] = selects
+	}
+	message := Message{}
+	if _, err := mgc.service.session.Get(ctx, path, params, &message, opts...); err != nil {
 		return nil, err
 	}
+	return &message, nil
+}
 
-	return &result, nil
+// EventResponseGetCall struct allowing for fluent style configuration of calls to get an eventMessageResponse.
+type EventResponseGetCall struct {
+	service   *MessageService
+	messageID string
+}
+
+// GetEventResponse returns an instance of an EventResponseGetCall for the given messageID, which must refer to an attendee's response to a meeting.
+func (ms *MessageService) GetEventResponse(messageID string) *EventResponseGetCall {
+	return &EventResponseGetCall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's event response, including any proposed new time.
+func (ergc *EventResponseGetCall) Do(ctx context.Context, opts ...RequestOption) (*EventMessageResponse, error) {
+	path := fmt.Sprintf("%s/%s", ergc.service.basePath, ergc.messageID)
+	response := EventMessageResponse{}
+	if _, err := ergc.service.session.Get(ctx, path, nil, &response, opts...); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// eventMessageExpand the $expand query parameter reading an event message's meeting along with it.
+const eventMessageExpand = "microsoft.graph.eventMessage/event"
+
+// EventMessageGetCall struct allowing for fluent style configuration of calls to get an eventMessage.
+type EventMessageGetCall struct {
+	service     *MessageService
+	messageID   string
+	expandEvent bool
+}
+
+// GetEventMessage returns an instance of an EventMessageGetCall for the given messageID, which must refer to a meeting message, see Message.IsEventMessage.
+func (ms *MessageService) GetEventMessage(messageID string) *EventMessageGetCall {
+	return &EventMessageGetCall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// ExpandEvent sets the $expand query parameter so the message is returned with the meeting in the user's calendar.
+func (emgc *EventMessageGetCall) ExpandEvent() *EventMessageGetCall {
+	emgc.expandEvent = true
+	return emgc
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's event message.
+func (emgc *EventMessageGetCall) Do(ctx context.Context, opts ...RequestOption) (*EventMessage, error) {
+	message := EventMessage{}
+	if err := getEventMessage(ctx, emgc.service, emgc.messageID, emgc.expandEvent, &message, opts); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// EventMessageRequestGetCall struct allowing for fluent style configuration of calls to get an eventMessageRequest.
+type EventMessageRequestGetCall struct {
+	service     *MessageService
+	messageID   string
+	expandEvent bool
+}
+
+// GetEventMessageRequest returns an instance of an EventMessageRequestGetCall for the given messageID, which must refer to
+// an invitation to a meeting, see Message.IsMeetingRequest.
+func (ms *MessageService) GetEventMessageRequest(messageID string) *EventMessageRequestGetCall {
+	return &EventMessageRequestGetCall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// ExpandEvent sets the $expand query parameter so the invitation is returned with the meeting in the user's calendar.
+func (emrgc *EventMessageRequestGetCall) ExpandEvent() *EventMessageRequestGetCall {
+	emrgc.expandEvent = true
+	return emrgc
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's invitation, including any previous time and location of an update.
+func (emrgc *EventMessageRequestGetCall) Do(ctx context.Context, opts ...RequestOption) (*EventMessageRequest, error) {
+	request := EventMessageRequest{}
+	if err := getEventMessage(ctx, emrgc.service, emrgc.messageID, emrgc.expandEvent, &request, opts); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func getEventMessage(ctx context.Context, ms *MessageService, messageID string, expandEvent bool, result interface{}, opts []RequestOption) error {
+	path := fmt.Sprintf("%s/%s", ms.basePath, messageID)
+	var params map[string]interface{}
+	if expandEvent {
+		params = map[string]interface{}{"$expand": eventMessageExpand}
+	}
+	_, err := ms.session.Get(ctx, path, params, result, opts...)
+	return err
+}
+
+// AcceptInvite returns an instance of an EventRespondCall which accepts the meeting the given invitation is for, so invitations
+// can be processed straight from the inbox. The meeting is looked up from the message when the call is executed.
+func (ms *MessageService) AcceptInvite(messageID string) *EventRespondCall {
+	return ms.respondToInvite(messageID, EventResponseAccept)
+}
+
+// DeclineInvite returns an instance of an EventRespondCall which declines the meeting the given invitation is for.
+func (ms *MessageService) DeclineInvite(messageID string) *EventRespondCall {
+	return ms.respondToInvite(messageID, EventResponseDecline)
+}
+
+// TentativelyAcceptInvite returns an instance of an EventRespondCall which tentatively accepts the meeting the given invitation is for.
+func (ms *MessageService) TentativelyAcceptInvite(messageID string) *EventRespondCall {
+	return ms.respondToInvite(messageID, EventResponseTentativelyAccept)
+}
+
+func (ms *MessageService) respondToInvite(messageID, action string) *EventRespondCall {
+	erc := NewEventService(ms.session).respond("", action)
+	erc.messageID = messageID
+	return erc
+}
+
+// MessageConversationCall struct allowing for fluent style configuration of calls gathering the messages of a conversation.
+type MessageConversationCall struct {
+	service        *MessageService
+	conversationID string
+	pageSize       int64
+	selects        messageSelect
+}
+
+// ListByConversation returns a MessageConversationCall gathering every message of the given conversation across all of the mailbox's folders.
+func (ms *MessageService) ListByConversation(conversationID string) *MessageConversationCall {
+	return &MessageConversationCall{
+		service:        ms,
+		conversationID: conversationID,
+		pageSize:       50,
+	}
+}
+
+// PageSize sets the $top query parameter used for each page the call fetches.
+func (mcc *MessageConversationCall) PageSize(pageSize int64) *MessageConversationCall {
+	mcc.pageSize = pageSize
+	return mcc
+}
+
+// UniqueBody sets the $select query parameter so each message is returned with its uniqueBody, leaving out the quoted
+// messages the conversation already holds.
+func (mcc *MessageConversationCall) UniqueBody() *MessageConversationCall {
+	mcc.selects = messageSelectUniqueBody
+	return mcc
+}
+
+// BodyPreviewOnly sets the $select query parameter so each message is returned with its bodyPreview but without its body.
+func (mcc *MessageConversationCall) BodyPreviewOnly() *MessageConversationCall {
+	mcc.selects = messageSelectBodyPreview
+	return mcc
+}
+
+// OmitBody sets the $select query parameter so each message is returned without its body or bodyPreview.
+func (mcc *MessageConversationCall) OmitBody() *MessageConversationCall {
+	mcc.selects = messageSelectOmitBody
+	return mcc
+}
+
+// Do executes the call, following every page and returning the conversation's messages ordered by receivedDateTime, oldest first.
+// Graph rejects ordering a conversationId filter on the server, so the messages are ordered once they have all been fetched.
+func (mcc *MessageConversationCall) Do(ctx context.Context, opts ...RequestOption) ([]*Message, error) {
+	params := map[string]interface{}{
+		"$top":    mcc.pageSize,
+		"$filter": fmt.Sprintf("conversationId eq '%s'", strings.ReplaceAll(mcc.conversationID, "'", "''")),
+	}
+	if selects := mcc.selects.param(mcc.service.session); selects != "" {
+		params["$select"] = selects
+	}
+
+	var messages []*Message
+	for {
+		var result MessageListResult
+		if _, err := mcc.service.session.Get(ctx, mcc.service.basePath, params, &result, opts...); err != nil {
+			return nil, err
+		}
+		messages = append(messages, result.Value...)
+		if result.NextLink == "" {
+			break
+		}
+		params["$skip"] = parsePageLink(result.NextLink, "$skip")
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return receivedTime(messages[i]).Before(receivedTime(messages[j]))
+	})
+	return messages, nil
+}
+
+func receivedTime(message *Message) time.Time {
+	received, _ := time.Parse(time.RFC3339, message.ReceivedOn)
+	return received
+}
+
+// MessageDeleteCall struct allowing for fluent style configuration of calls to the message delete endpoints.
+type MessageDeleteCall struct {
+	service   *MessageService
+	messageID string
+	permanent bool
+}
+
+// Delete returns an instance of a MessageDeleteCall deleting the given message, which graph moves to Deleted Items.
+func (ms *MessageService) Delete(messageID string) *MessageDeleteCall {
+	return &MessageDeleteCall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// PermanentDelete returns an instance of a MessageDeleteCall permanently deleting the given message, bypassing Deleted Items.
+// The message is moved to the Purges folder of the mailbox's recoverable items, from where it can not be restored by the user.
+func (ms *MessageService) PermanentDelete(messageID string) *MessageDeleteCall {
+	return &MessageDeleteCall{
+		service:   ms,
+		messageID: messageID,
+		permanent: true,
+	}
+}
+
+// Do executes the http request to microsoft's graph api to delete the call's message.
+func (mdc *MessageDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	_, err := mdc.do(ctx, opts)
+	return err
+}
+
+func (mdc *MessageDeleteCall) do(ctx context.Context, opts []RequestOption) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", mdc.service.basePath, mdc.messageID)
+	if mdc.permanent {
+		return mdc.service.session.Post(ctx, path+"/permanentDelete", nil, nil, opts...)
+	}
+	return mdc.service.session.Delete(ctx, path, nil, nil, opts...)
+}
+
+// MessageEmptyFolderCall struct allowing for fluent style configuration of calls deleting every message in a folder.
+type MessageEmptyFolderCall struct {
+	service   *MessageService
+	folderID  string
+	batchSize int64
+	permanent bool
+}
+
+// EmptyFolder returns an instance of a MessageEmptyFolderCall deleting every message in the given folder. Child folders are left as they are.
+func (ms *MessageService) EmptyFolder(folderID string) *MessageEmptyFolderCall {
+	return &MessageEmptyFolderCall{
+		service:   ms,
+		folderID:  folderID,
+		batchSize: 20,
+	}
+}
+
+// BatchSize sets how many messages are listed, and then deleted concurrently, at a time.
+// Deletes to a single mailbox are additionally bounded by the client's mailbox concurrency.
+func (mefc *MessageEmptyFolderCall) BatchSize(batchSize int64) *MessageEmptyFolderCall {
+	mefc.batchSize = batchSize
+	return mefc
+}
+
+// Permanent permanently deletes the messages, see PermanentDelete, instead of moving them to Deleted Items.
+func (mefc *MessageEmptyFolderCall) Permanent(permanent bool) *MessageEmptyFolderCall {
+	mefc.permanent = permanent
+	return mefc
+}
+
+// Do executes the call, deleting batches of messages until the folder is empty, and returns how many were deleted.
+// When a delete fails the call stops once its batch is done, returning the count so far along with the first error.
+// In dry-run mode nothing is deleted, so the call stops after its first batch, returning how many messages it would have deleted.
+// Options other than query parameters, such as headers and timeouts, are passed on to each delete as well as to the listing.
+func (mefc *MessageEmptyFolderCall) Do(ctx context.Context, opts ...RequestOption) (int, error) {
+	// Deleting shifts the remaining messages up, so every batch is read from the start of the folder.
+	path := fmt.Sprintf("/mailFolders/%s%s", mefc.folderID, mefc.service.basePath)
+	params := map[string]interface{}{
+		"$top":    mefc.batchSize,
+		"$select": "id",
+	}
+	deleteOpts := withoutQueryParams(opts)
+
+	deleted := 0
+	for {
+		var page MessageListResult
+		if _, err := mefc.service.session.Get(ctx, path, params, &page, opts...); err != nil {
+			return deleted, err
+		}
+		if len(page.Value) == 0 {
+			return deleted, nil
+		}
+
+		errs := make([]error, len(page.Value))
+		dryRun := make([]bool, len(page.Value))
+		var wg sync.WaitGroup
+		for i, message := range page.Value {
+			call := mefc.service.Delete(message.ID)
+			call.permanent = mefc.permanent
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				response, err := call.do(ctx, deleteOpts)
+				errs[i] = err
+				dryRun[i] = response != nil && response.Header.Get(DryRunHeader) != ""
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			if err == nil {
+				deleted++
+			}
+		}
+		for _, err := range errs {
+			if err != nil {
+				return deleted, err
+			}
+		}
+		for _, skipped := range dryRun {
+			if skipped {
+				// The messages are still there, so listing again would return the same batch forever.
+				return deleted, nil
+			}
+		}
+	}
+}
+
+// MessageHydrateCall struct allowing for fluent style configuration of calls reading the bodies of listed messages.
+type MessageHydrateCall struct {
+	service    *MessageService
+	messages   []*Message
+	uniqueBody bool
+}
+
+// HydrateBodies returns an instance of a MessageHydrateCall reading the bodies of messages listed without them, e.g. with
+// MessageListCall.BodyPreviewOnly, once they are needed, such as when a message is opened from an inbox screen.
+func (ms *MessageService) HydrateBodies(messages ...*Message) *MessageHydrateCall {
+	return &MessageHydrateCall{
+		service:  ms,
+		messages: messages,
+	}
+}
+
+// UniqueBody reads the messages' uniqueBody, the newest part of their reply chain, rather than their full body.
+func (mhc *MessageHydrateCall) UniqueBody() *MessageHydrateCall {
+	mhc.uniqueBody = true
+	return mhc
+}
+
+// Do executes the call, concurrently reading the body of each message which does not have one yet and setting it on the
+// message. Reads to a single mailbox are bounded by the client's mailbox concurrency. Messages whose read failed are left
+// as they were, and the first error is returned once every read is done.
+func (mhc *MessageHydrateCall) Do(ctx context.Context, opts ...RequestOption) error {
+	field := "body"
+	if mhc.uniqueBody {
+		field = "uniqueBody"
+	}
+	params := map[string]interface{}{"$select": field}
+
+	errs := make([]error, len(mhc.messages))
+	var wg sync.WaitGroup
+	for i, message := range mhc.messages {
+		if message == nil || (!mhc.uniqueBody && message.Body != nil) || (mhc.uniqueBody && message.UniqueBody != nil) {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, message *Message) {
+			defer wg.Done()
+			var hydrated Message
+			path := fmt.Sprintf("%s/%s", mhc.service.basePath, message.ID)
+			if _, err := mhc.service.session.Get(ctx, path, params, &hydrated, opts...); err != nil {
+				errs[i] = err
+				return
+			}
+			message.Body, message.UniqueBody = coalesceBody(hydrated.Body, message.Body), coalesceBody(hydrated.UniqueBody, message.UniqueBody)
+		}(i, message)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func coalesceBody(bodies ...*MessageBody) *MessageBody {
+	for _, body := range bodies {
+		if body != nil {
+			return body
+		}
+	}
+	return nil
+}
+
+// MessageMoveCall struct allowing for fluent style configuration of calls to the message move and copy endpoints.
+type MessageMoveCall struct {
+	service       *MessageService
+	messageID     string
+	destinationID string
+	action        string
+	resolve       bool
+}
+
+// Move returns an instance of a MessageMoveCall moving the given message into the destination folder, which may be a well-known folder name.
+func (ms *MessageService) Move(messageID, destinationID string) *MessageMoveCall {
+	return &MessageMoveCall{
+		service:       ms,
+		messageID:     messageID,
+		destinationID: destinationID,
+		action:        "move",
+	}
+}
+
+// Copy returns an instance of a MessageMoveCall copying the given message into the destination folder, which may be a well-known folder name.
+func (ms *MessageService) Copy(messageID, destinationID string) *MessageMoveCall {
+	return &MessageMoveCall{
+		service:       ms,
+		messageID:     messageID,
+		destinationID: destinationID,
+		action:        "copy",
+	}
+}
+
+// Archive returns an instance of a MessageMoveCall moving the given message to the mailbox's archive folder, like Outlook's archive button.
+// The archive folder's id is resolved, and cached, first, so mailboxes without one fail with a not found error before anything is moved.
+func (ms *MessageService) Archive(messageID string) *MessageMoveCall {
+	return &MessageMoveCall{
+		service:       ms,
+		messageID:     messageID,
+		destinationID: FolderArchive,
+		action:        "move",
+		resolve:       true,
+	}
+}
+
+// Do executes the http post request to microsoft's graph api, returning the moved message, which has a new id, or the new copy.
+func (mmc *MessageMoveCall) Do(ctx context.Context, opts ...RequestOption) (*Message, error) {
+	destinationID := mmc.destinationID
+	if mmc.resolve {
+		resolved, err := mmc.service.session.Folders().Resolve(destinationID).Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		destinationID = resolved
+	}
+
+	path := fmt.Sprintf("%s/%s/%s", mmc.service.basePath, mmc.messageID, mmc.action)
+	body := map[string]interface{}{
+		"destinationId": destinationID,
+	}
+	message := Message{}
+	if _, err := mmc.service.session.Post(ctx, path, body, &message, opts...); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// DeferredSendTimePropertyID PidTagDeferredSendTime, the MAPI property holding the time exchange holds a sent message in
+// the Outbox until, which graph has no first class property for.
+const DeferredSendTimePropertyID = "SystemTime 0x3FEF"
+
+// MessageSendCall struct allowing for fluent style configuration of calls sending a draft.
+type MessageSendCall struct {
+	service   *MessageService
+	messageID string
+	sendAt    time.Time
+}
+
+// SendDraft returns an instance of a MessageSendCall sending the draft with the given messageID.
+func (ms *MessageService) SendDraft(messageID string) *MessageSendCall {
+	return &MessageSendCall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// SendAt defers the send until the given time: the draft is sent right away, but exchange holds it in the Outbox, where it can
+// still be deleted, until then. Times in the past send the message immediately. The time is set on the draft before it is
+// sent, and remains there if the send then fails.
+func (msc *MessageSendCall) SendAt(sendAt time.Time) *MessageSendCall {
+	msc.sendAt = sendAt
+	return msc
+}
+
+// Do executes the send, first setting the draft's deferred send time when one is set. ErrSendAsDenied is returned, wrapping
+// the underlying status error, when the caller lacks the rights to send as the draft's from address.
+func (msc *MessageSendCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", msc.service.basePath, msc.messageID)
+	if !msc.sendAt.IsZero() {
+		body := map[string]interface{}{
+			"singleValueExtendedProperties": []*SingleValueExtendedProperty{{
+				ID:    DeferredSendTimePropertyID,
+				Value: msc.sendAt.UTC().Format(time.RFC3339),
+			}},
+		}
+		if _, err := msc.service.session.Patch(ctx, path, body, nil, opts...); err != nil {
+			return err
+		}
+	}
+
+	if _, err := msc.service.session.Post(ctx, path+"/send", nil, nil, opts...); err != nil {
+		if isSendAsDenied(err) {
+			return fmt.Errorf("%w: %w", ErrSendAsDenied, err)
+		}
+		return err
+	}
+	return nil
 }
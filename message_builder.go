@@ -0,0 +1,260 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// MaxInlineAttachmentSize the largest total attachment size graph accepts in a single sendMail or message create request.
+// Larger attachments must be uploaded to a draft through an upload session.
+const MaxInlineAttachmentSize = 3 * 1024 * 1024
+
+// MessageBuilder struct allowing for fluent style composition of a Message. Errors, such as unreadable attachments
+// or unparsable addresses, are deferred until Build or Send.
+type MessageBuilder struct {
+	message         *Message
+	textAlternative string
+	fromAlias       bool
+	sendAt          time.Time
+	err             error
+}
+
+// NewMessageBuilder returns a new MessageBuilder for an empty message.
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{message: &Message{}}
+}
+
+// To adds to recipients, given either as bare addresses or in "Name <address>" form.
+func (mb *MessageBuilder) To(addresses ...string) *MessageBuilder {
+	mb.message.To = append(mb.message.To, mb.recipients(addresses)...)
+	return mb
+}
+
+// CC adds cc recipients, given either as bare addresses or in "Name <address>" form.
+func (mb *MessageBuilder) CC(addresses ...string) *MessageBuilder {
+	mb.message.CC = append(mb.message.CC, mb.recipients(addresses)...)
+	return mb
+}
+
+// BCC adds bcc recipients, given either as bare addresses or in "Name <address>" form.
+func (mb *MessageBuilder) BCC(addresses ...string) *MessageBuilder {
+	mb.message.BCC = append(mb.message.BCC, mb.recipients(addresses)...)
+	return mb
+}
+
+// ReplyTo adds addresses replies should be sent to instead of the sender.
+func (mb *MessageBuilder) ReplyTo(addresses ...string) *MessageBuilder {
+	mb.message.ReplyTo = append(mb.message.ReplyTo, mb.recipients(addresses)...)
+	return mb
+}
+
+// From sets the mailbox the message is sent from, see Session.Send for the rights this requires.
+func (mb *MessageBuilder) From(address string) *MessageBuilder {
+	if recipients := mb.recipients([]string{address}); len(recipients) == 1 {
+		mb.message.From = recipients[0]
+	}
+	return mb
+}
+
+// FromAlias sets the message's from address to one of the sending mailbox's own aliases, given either bare or in
+// "Name <address>" form, for users with several addresses to choose which they send as. Send checks the address against
+// the mailbox's aliases, see Session.Aliases, and fails with ErrUnknownAlias if it is not one of them.
+func (mb *MessageBuilder) FromAlias(address string) *MessageBuilder {
+	mb.fromAlias = true
+	return mb.From(address)
+}
+
+// Mention @mentions the given address, given either bare or in "Name <address>" form, when the message is created.
+// Mentions are only supported by graph's beta api, see Session.WithAPIVersion: Send and SaveDraft fail with ErrBetaOnly on other
+// sessions. They can't be combined with TextAlternative. The body should also name the mentioned user.
+func (mb *MessageBuilder) Mention(address string) *MessageBuilder {
+	if recipients := mb.recipients([]string{address}); len(recipients) == 1 {
+		mb.message.Mentions = append(mb.message.Mentions, &Mention{Mentioned: recipients[0].EmailAddress})
+	}
+	return mb
+}
+
+// Subject sets the message's subject.
+func (mb *MessageBuilder) Subject(subject string) *MessageBuilder {
+	mb.message.Subject = subject
+	return mb
+}
+
+// HTMLBody sets the message's body to the given html.
+func (mb *MessageBuilder) HTMLBody(html string) *MessageBuilder {
+	mb.message.Body = &MessageBody{ContentType: BodyContentTypeHTML, Content: html}
+	return mb
+}
+
+// TextBody sets the message's body to the given plain text.
+func (mb *MessageBuilder) TextBody(text string) *MessageBuilder {
+	mb.message.Body = &MessageBody{ContentType: BodyContentTypeText, Content: text}
+	return mb
+}
+
+// Importance sets the message's importance, e.g. ImportanceHigh.
+func (mb *MessageBuilder) Importance(importance Importance) *MessageBuilder {
+	if !importance.Valid() {
+		mb.setErr(fmt.Errorf("message: invalid importance %q", importance))
+	}
+	mb.message.Importance = importance
+	return mb
+}
+
+// RequestReadReceipt asks the recipients' mail clients to notify the sender when the message is read.
+func (mb *MessageBuilder) RequestReadReceipt() *MessageBuilder {
+	mb.message.IsReadReceiptRequested = true
+	return mb
+}
+
+// RequestDeliveryReceipt asks the recipients' mail servers to notify the sender when the message is delivered.
+func (mb *MessageBuilder) RequestDeliveryReceipt() *MessageBuilder {
+	mb.message.IsDeliveryReceiptRequested = true
+	return mb
+}
+
+// SendAt defers sending the message until the given time. Send then saves the message as a draft and sends it with
+// MessageService.SendDraft, leaving exchange to hold it in the Outbox until sendAt.
+func (mb *MessageBuilder) SendAt(sendAt time.Time) *MessageBuilder {
+	mb.sendAt = sendAt
+	return mb
+}
+
+// Attach adds a file attachment with the given name, content type, and content.
+func (mb *MessageBuilder) Attach(name, contentType string, content []byte) *MessageBuilder {
+	mb.message.Attachments = append(mb.message.Attachments, &Attachment{
+		ODataType:    AttachmentODataTypeFile,
+		Name:         name,
+		ContentType:  contentType,
+		Size:         len(content),
+		ContentBytes: content,
+	})
+	return mb
+}
+
+// AttachInline adds a file attachment which the html body references as cid:contentID, e.g. an embedded image.
+func (mb *MessageBuilder) AttachInline(contentID, name, contentType string, content []byte) *MessageBuilder {
+	mb.Attach(name, contentType, content)
+	attachment := mb.message.Attachments[len(mb.message.Attachments)-1]
+	attachment.IsInline = true
+	attachment.ContentID = contentID
+	return mb
+}
+
+// AttachReader adds a file attachment with the content read from r.
+func (mb *MessageBuilder) AttachReader(name, contentType string, r io.Reader) *MessageBuilder {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		mb.setErr(fmt.Errorf("failed to read attachment %s: %w", name, err))
+		return mb
+	}
+	return mb.Attach(name, contentType, content)
+}
+
+// AttachFile adds the file at path as an attachment, named after the file and typed after its extension.
+func (mb *MessageBuilder) AttachFile(path string) *MessageBuilder {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		mb.setErr(fmt.Errorf("failed to read attachment: %w", err))
+		return mb
+	}
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return mb.Attach(filepath.Base(path), contentType, content)
+}
+
+// Build returns the composed message, or the first error encountered while composing it.
+func (mb *MessageBuilder) Build() (*Message, error) {
+	if mb.err != nil {
+		return nil, mb.err
+	}
+	if len(mb.message.To)+len(mb.message.CC)+len(mb.message.BCC) == 0 {
+		return nil, fmt.Errorf("message: at least one recipient is required")
+	}
+	if len(mb.message.Mentions) > 0 && mb.textAlternative != "" {
+		// Messages with a text alternative are sent as MIME, which has no way to carry graph's mentions.
+		return nil, fmt.Errorf("message: mentions can't be sent with a text alternative")
+	}
+
+	total := 0
+	for _, attachment := range mb.message.Attachments {
+		total += attachment.Size
+	}
+	if total > MaxInlineAttachmentSize {
+		return nil, fmt.Errorf("message: attachments total %d bytes, more than the %d bytes graph accepts inline", total, MaxInlineAttachmentSize)
+	}
+
+	message := *mb.message
+	message.HasAttachments = len(message.Attachments) > 0
+	return &message, nil
+}
+
+// Send builds the message and sends it from the given session's mailbox.
+func (mb *MessageBuilder) Send(ctx context.Context, session *Session, opts ...RequestOption) error {
+	message, err := mb.Build()
+	if err != nil {
+		return err
+	}
+	if err := mb.checkAPIVersion(session); err != nil {
+		return err
+	}
+	if mb.fromAlias {
+		aliases, err := session.Aliases().Do(ctx, opts...)
+		if err != nil {
+			return err
+		}
+		if err := aliases.Validate(message.From); err != nil {
+			return err
+		}
+	}
+	if !mb.sendAt.IsZero() {
+		draft, err := mb.SaveDraft(ctx, session, opts...)
+		if err != nil {
+			return err
+		}
+		return session.Messages().SendDraft(draft.ID).SendAt(mb.sendAt).Do(ctx, opts...)
+	}
+	if mb.textAlternative != "" {
+		var buf bytes.Buffer
+		if err := WriteMIME(&buf, message, mb.textAlternative); err != nil {
+			return err
+		}
+		return session.SendMIME(ctx, buf.Bytes(), opts...)
+	}
+	return session.Send(ctx, message, opts...)
+}
+
+// checkAPIVersion returns an error if the message uses features the session's api version does not support.
+func (mb *MessageBuilder) checkAPIVersion(session *Session) error {
+	if len(mb.message.Mentions) > 0 && !session.usesBeta() {
+		return fmt.Errorf("message: mentions: %w", ErrBetaOnly)
+	}
+	return nil
+}
+
+func (mb *MessageBuilder) recipients(addresses []string) []*Recipient {
+	recipients := make([]*Recipient, 0, len(addresses))
+	for _, address := range addresses {
+		recipient, err := ParseRecipient(address)
+		if err != nil {
+			mb.setErr(fmt.Errorf("message: %w", err))
+			continue
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients
+}
+
+func (mb *MessageBuilder) setErr(err error) {
+	if mb.err == nil {
+		mb.err = err
+	}
+}
@@ -0,0 +1,255 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// mimeBody a MIME message sent as the base64 encoded text/plain body graph expects for MIME sends and drafts.
+type mimeBody []byte
+
+// SendMIME sends a complete RFC 5322 MIME message from the session's mailbox. This allows content graph's json message
+// format cannot express, such as multipart/alternative html and plain text bodies, or S/MIME content, see SignMIME and EncryptMIME.
+func (session *Session) SendMIME(ctx context.Context, message []byte, opts ...RequestOption) error {
+	_, err := session.query(ctx, http.MethodPost, "/sendMail", nil, nil, mimeBody(message), nil, opts...)
+	if isSendAsDenied(err) {
+		return fmt.Errorf("%w: %w", ErrSendAsDenied, err)
+	}
+	return err
+}
+
+// CreateDraftMIME creates a draft in the session's mailbox from a complete RFC 5322 MIME message, returning the draft.
+func (session *Session) CreateDraftMIME(ctx context.Context, message []byte, opts ...RequestOption) (*Message, error) {
+	var draft Message
+	if _, err := session.query(ctx, http.MethodPost, "/messages", nil, nil, mimeBody(message), &draft, opts...); err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// MessageMIMECall struct allowing for fluent style configuration of calls reading a message's MIME content.
+type MessageMIMECall struct {
+	service   *MessageService
+	messageID string
+}
+
+// GetMIME returns an instance of a MessageMIMECall reading the RFC 5322 MIME content of the message with the given id,
+// as exchange stores it, including its attachments.
+func (ms *MessageService) GetMIME(messageID string) *MessageMIMECall {
+	return &MessageMIMECall{
+		service:   ms,
+		messageID: messageID,
+	}
+}
+
+// Do executes the call, returning the message's MIME content.
+func (mmc *MessageMIMECall) Do(ctx context.Context, opts ...RequestOption) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := mmc.Stream(ctx, &buf, opts...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Stream executes the call, copying the message's MIME content to w as it is read rather than holding it in memory,
+// which matters for messages with large attachments.
+func (mmc *MessageMIMECall) Stream(ctx context.Context, w io.Writer, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s/$value", mmc.service.basePath, mmc.messageID)
+	_, err := mmc.service.session.Get(ctx, path, nil, w, opts...)
+	return err
+}
+
+// WriteMIME writes message to w as an RFC 5322 MIME message. When textAlternative is set and the message's body is html,
+// the body is written as a multipart/alternative of the plain text and html versions.
+func WriteMIME(w io.Writer, message *Message, textAlternative string) error {
+	var buf bytes.Buffer
+	header := textproto.MIMEHeader{}
+	header.Set("MIME-Version", "1.0")
+	if message.MessageID != "" {
+		header.Set("Message-ID", message.MessageID)
+	}
+	date := time.Now()
+	if message.SentOn != "" {
+		if sent, err := time.Parse(time.RFC3339, message.SentOn); err == nil {
+			date = sent
+		}
+	}
+	header.Set("Date", date.Format(time.RFC1123Z))
+	if message.From != nil {
+		header.Set("From", FormatRecipients([]*Recipient{message.From}))
+	}
+	if message.Sender != nil && (message.From == nil || !sameAddress(message.Sender, message.From)) {
+		header.Set("Sender", FormatRecipients([]*Recipient{message.Sender}))
+	}
+	for key, recipients := range map[string][]*Recipient{"To": message.To, "Cc": message.CC, "Bcc": message.BCC, "Reply-To": message.ReplyTo} {
+		if len(recipients) > 0 {
+			header.Set(key, FormatRecipients(recipients))
+		}
+	}
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	if message.Importance != "" && message.Importance != ImportanceNormal {
+		header.Set("Importance", string(message.Importance))
+	}
+	// Receipts are sent to the From address, so without one exchange falls back to the mailbox's own and the headers are left out.
+	if message.From != nil {
+		if message.IsReadReceiptRequested {
+			header.Set("Disposition-Notification-To", FormatRecipients([]*Recipient{message.From}))
+		}
+		if message.IsDeliveryReceiptRequested {
+			header.Set("Return-Receipt-To", FormatRecipients([]*Recipient{message.From}))
+		}
+	}
+
+	body := message.Body
+	if body == nil {
+		body = &MessageBody{ContentType: BodyContentTypeText}
+	}
+	if len(message.Attachments) == 0 {
+		if err := writeMIMEBody(&buf, header, body, textAlternative); err != nil {
+			return err
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	header.Set("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%q", mixed.Boundary()))
+	buf.Reset()
+	writeHeader(&buf, header)
+	bodyPart := &bytes.Buffer{}
+	if err := writeMIMEBody(bodyPart, textproto.MIMEHeader{}, body, textAlternative); err != nil {
+		return err
+	}
+	partHeader, partBody := splitHeader(bodyPart.Bytes())
+	part, err := mixed.CreatePart(partHeader)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(partBody); err != nil {
+		return err
+	}
+
+	for _, attachment := range message.Attachments {
+		attachmentHeader := textproto.MIMEHeader{}
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		attachmentHeader.Set("Content-Type", mime.FormatMediaType(contentType, map[string]string{"name": attachment.Name}))
+		disposition := "attachment"
+		if attachment.IsInline {
+			disposition = "inline"
+		}
+		attachmentHeader.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Name}))
+		if attachment.ContentID != "" {
+			attachmentHeader.Set("Content-ID", "<"+attachment.ContentID+">")
+		}
+		attachmentHeader.Set("Content-Transfer-Encoding", "base64")
+		part, err := mixed.CreatePart(attachmentHeader)
+		if err != nil {
+			return err
+		}
+		if err := writeBase64Lines(part, attachment.ContentBytes); err != nil {
+			return err
+		}
+	}
+	if err := mixed.Close(); err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// writeMIMEBody writes header, completed with the body's content type, and the body itself.
+func writeMIMEBody(buf *bytes.Buffer, header textproto.MIMEHeader, body *MessageBody, textAlternative string) error {
+	isHTML := strings.EqualFold(body.ContentType, BodyContentTypeHTML)
+	if !isHTML || textAlternative == "" {
+		contentType := "text/plain"
+		if isHTML {
+			contentType = "text/html"
+		}
+		header.Set("Content-Type", contentType+"; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(buf, header)
+		return writeQuotedPrintable(buf, body.Content)
+	}
+
+	alternative := multipart.NewWriter(buf)
+	header.Set("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", alternative.Boundary()))
+	writeHeader(buf, header)
+	for _, alt := range []struct{ contentType, content string }{{"text/plain", textAlternative}, {"text/html", body.Content}} {
+		part, err := alternative.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {alt.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return err
+		}
+		if err := writeQuotedPrintable(part, alt.content); err != nil {
+			return err
+		}
+	}
+	return alternative.Close()
+}
+
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+// splitHeader splits a written part into its header and body.
+func splitHeader(part []byte) (textproto.MIMEHeader, []byte) {
+	head, body, _ := bytes.Cut(part, []byte("\r\n\r\n"))
+	header := textproto.MIMEHeader{}
+	for _, line := range strings.Split(string(head), "\r\n") {
+		if key, value, ok := strings.Cut(line, ": "); ok {
+			header.Add(key, value)
+		}
+	}
+	return header, body
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeBase64Lines(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(w, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err := io.WriteString(w, encoded+"\r\n")
+	return err
+}
+
+func sameAddress(a, b *Recipient) bool {
+	return a.EmailAddress != nil && b.EmailAddress != nil && strings.EqualFold(a.EmailAddress.Address, b.EmailAddress.Address)
+}
@@ -0,0 +1,125 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+// MessageTemplate renders the subject and body of a message from data, with an html body, a plain text body, or both.
+// When both are set, messages are sent as MIME with the plain text as an alternative for clients which do not render html.
+type MessageTemplate struct {
+	Subject *texttemplate.Template
+	HTML    *htmltemplate.Template
+	Text    *texttemplate.Template
+}
+
+// ParseMessageTemplate returns a new MessageTemplate parsed from the given template sources. Either body may be empty.
+func ParseMessageTemplate(subject, html, text string) (*MessageTemplate, error) {
+	if html == "" && text == "" {
+		return nil, fmt.Errorf("message template: an html or text body is required")
+	}
+
+	mt := &MessageTemplate{}
+	var err error
+	if mt.Subject, err = texttemplate.New("subject").Parse(subject); err != nil {
+		return nil, fmt.Errorf("message template: subject: %w", err)
+	}
+	if html != "" {
+		if mt.HTML, err = htmltemplate.New("html").Parse(html); err != nil {
+			return nil, fmt.Errorf("message template: html: %w", err)
+		}
+	}
+	if text != "" {
+		if mt.Text, err = texttemplate.New("text").Parse(text); err != nil {
+			return nil, fmt.Errorf("message template: text: %w", err)
+		}
+	}
+	return mt, nil
+}
+
+// RenderedMessage the output of a MessageTemplate for a single set of data.
+type RenderedMessage struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Render executes the template with data.
+func (mt *MessageTemplate) Render(data interface{}) (*RenderedMessage, error) {
+	rendered := &RenderedMessage{}
+	var buf bytes.Buffer
+	if mt.Subject != nil {
+		if err := mt.Subject.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("message template: subject: %w", err)
+		}
+		rendered.Subject = buf.String()
+	}
+	if mt.HTML != nil {
+		buf.Reset()
+		if err := mt.HTML.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("message template: html: %w", err)
+		}
+		rendered.HTML = buf.String()
+	}
+	if mt.Text != nil {
+		buf.Reset()
+		if err := mt.Text.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("message template: text: %w", err)
+		}
+		rendered.Text = buf.String()
+	}
+	return rendered, nil
+}
+
+// Template renders tmpl with data into the builder's subject and body. When the template has both an html and a text body,
+// the text is kept as an alternative and the message is sent as MIME.
+func (mb *MessageBuilder) Template(tmpl *MessageTemplate, data interface{}) *MessageBuilder {
+	rendered, err := tmpl.Render(data)
+	if err != nil {
+		mb.setErr(err)
+		return mb
+	}
+
+	mb.Subject(rendered.Subject)
+	mb.textAlternative = ""
+	switch {
+	case rendered.HTML != "":
+		mb.HTMLBody(rendered.HTML)
+		mb.textAlternative = rendered.Text
+	default:
+		mb.TextBody(rendered.Text)
+	}
+	return mb
+}
+
+// TextAlternative sets a plain text alternative to the html body, causing the message to be sent as multipart/alternative MIME.
+func (mb *MessageBuilder) TextAlternative(text string) *MessageBuilder {
+	mb.textAlternative = text
+	return mb
+}
+
+// SaveDraft builds the message and saves it as a draft in the given session's mailbox, returning the draft.
+func (mb *MessageBuilder) SaveDraft(ctx context.Context, session *Session, opts ...RequestOption) (*Message, error) {
+	message, err := mb.Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := mb.checkAPIVersion(session); err != nil {
+		return nil, err
+	}
+	if mb.textAlternative != "" {
+		var buf bytes.Buffer
+		if err := WriteMIME(&buf, message, mb.textAlternative); err != nil {
+			return nil, err
+		}
+		return session.CreateDraftMIME(ctx, buf.Bytes(), opts...)
+	}
+
+	if _, err := session.Post(ctx, "/messages", message, message, opts...); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
@@ -0,0 +1,100 @@
+package outlook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RequestMetrics the outcome of a single graph call, as reported to a MetricsRecorder.
+type RequestMetrics struct {
+	Method string
+	// Route the request's path template with identifiers replaced by {id}, suitable as a low cardinality label.
+	Route string
+	// StatusCode the final response's status code, 0 if no response was received.
+	StatusCode int
+	Duration   time.Duration
+	// RequestBytes the size of the request body.
+	RequestBytes int64
+	// ResponseBytes the number of response body bytes read.
+	ResponseBytes int64
+	// Retries the number of times middleware resent the request after the first attempt.
+	Retries int
+	// Throttled whether microsoft responded with a 429.
+	Throttled bool
+	Err       error
+}
+
+// MetricsRecorder receives the outcome of every graph call a Client makes, so operators can alert on graph health
+// by exporting counters and histograms to the metrics system of their choice.
+type MetricsRecorder interface {
+	RecordRequest(ctx context.Context, metrics RequestMetrics)
+}
+
+// MetricsRecorderFunc an adapter allowing an ordinary function to be used as a MetricsRecorder.
+type MetricsRecorderFunc func(ctx context.Context, metrics RequestMetrics)
+
+// RecordRequest calls f(ctx, metrics).
+func (f MetricsRecorderFunc) RecordRequest(ctx context.Context, metrics RequestMetrics) {
+	f(ctx, metrics)
+}
+
+// SetClientMetricsRecorder returns a ClientOpt function which sets the recorder the client reports request outcomes to.
+func SetClientMetricsRecorder(recorder MetricsRecorder) ClientOpt {
+	return func(c *Client) {
+		c.metrics = recorder
+	}
+}
+
+// requestStats the bookkeeping gathered while a single call is in flight.
+type requestStats struct {
+	start         time.Time
+	attempts      int
+	responseBytes int64
+	// waited the time spent queued behind the client's limiters and backing off between attempts.
+	waited time.Duration
+	// lastAttempt when the previous attempt returned, the start of any backoff before the next.
+	lastAttempt time.Time
+}
+
+func (client *Client) recordMetrics(ctx context.Context, req *http.Request, res *http.Response, err error, stats *requestStats) {
+	if client.metrics == nil {
+		return
+	}
+
+	metrics := RequestMetrics{
+		Method:        req.Method,
+		Route:         pathTemplate(req.URL.Path),
+		Duration:      time.Since(stats.start),
+		RequestBytes:  req.ContentLength,
+		ResponseBytes: stats.responseBytes,
+		Err:           err,
+	}
+	if stats.attempts > 1 {
+		metrics.Retries = stats.attempts - 1
+	}
+	if res != nil {
+		metrics.StatusCode = res.StatusCode
+	} else {
+		var graphErr *GraphError
+		if errors.As(err, &graphErr) {
+			metrics.StatusCode = graphErr.StatusCode
+		}
+	}
+	metrics.Throttled = metrics.StatusCode == http.StatusTooManyRequests
+	client.metrics.RecordRequest(ctx, metrics)
+}
+
+// countingReadCloser counts the bytes read through it into n.
+type countingReadCloser struct {
+	io.ReadCloser
+	n *int64
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	*c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,70 @@
+package outlook
+
+import (
+	"net/http"
+	"time"
+)
+
+// RoundTripperFunc an adapter allowing an ordinary function to be used as an http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the round trip of every request a Client makes, allowing cross-cutting behavior such as caching,
+// logging, or fault injection to be added without forking NewRequest or Do. A middleware must call next to continue
+// the chain, or return a response of its own to short-circuit it.
+type Middleware func(next RoundTripperFunc) RoundTripperFunc
+
+// SetClientMiddleware returns a ClientOpt function which appends the given middleware to the client's chain.
+// Middleware run in the order given, the first wrapping all the others.
+func SetClientMiddleware(middleware ...Middleware) ClientOpt {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
+
+// Use fluent configuration appending middleware to the client's chain.
+func (client *Client) Use(middleware ...Middleware) *Client {
+	client.middleware = append(client.middleware, middleware...)
+	return client
+}
+
+// roundTrip sends req through the client's middleware chain, ending with the underlying http client.
+// stats.attempts counts how many times the chain reached the network, so retries made by middleware are visible.
+// Every attempt, retries included, waits on the client's rate limiter.
+func (client *Client) roundTrip(req *http.Request, stats *requestStats) (*http.Response, error) {
+	next := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		stats.attempts++
+		if !stats.lastAttempt.IsZero() {
+			// Middleware retrying the request backed off since the previous attempt returned.
+			stats.waited += time.Since(stats.lastAttempt)
+		}
+		defer func() { stats.lastAttempt = time.Now() }()
+		if client.rateLimiter != nil {
+			waitStart := time.Now()
+			err := client.rateLimiter.Wait(req.Context())
+			stats.waited += time.Since(waitStart)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if client.dump != nil {
+			client.dump.dumpRequest(req)
+		}
+		res, err := client.client.Do(req)
+		if err == nil {
+			res, err = decompressResponse(res)
+		}
+		if client.dump != nil {
+			client.dump.dumpResponse(res, err)
+		}
+		return res, err
+	})
+	for i := len(client.middleware) - 1; i >= 0; i-- {
+		next = client.middleware[i](next)
+	}
+	return next(req)
+}
@@ -8,6 +8,10 @@ type User struct {
 	ID        string `json:"id,omitempty"`
 	Email     string `json:"userPrincipalName,omitempty"`
 	JobTitle  string `json:"jobTitle,omitempty"`
+	// Mail the user's primary smtp address.
+	Mail string `json:"mail,omitempty"`
+	// ProxyAddresses every address the user's mailbox receives mail at, in "type:address" form, see ParseProxyAddress.
+	ProxyAddresses []string `json:"proxyAddresses,omitempty"`
 }
 
 // RefreshTokenRequest microsoft token request object
@@ -45,6 +49,27 @@ type Folder struct {
 	ChildFolderCount int    `json:"childFolderCount,omitempty"`
 	UnreadItemCount  int    `json:"unreadItemCount,omitempty"`
 	TotalItemCount   int    `json:"totalItemCount,omitempty"`
+	IsHidden         bool   `json:"isHidden,omitempty"`
+	// SizeInBytes the size of the folder, only reported by graph where available.
+	SizeInBytes int64 `json:"sizeInBytes,omitempty"`
+}
+
+// SearchFolderListResult struct representing a response from the outlook mailFolders endpoint listing search folders
+type SearchFolderListResult struct {
+	Context  string          `json:"@odata.context,omitempty"`
+	NextLink string          `json:"@odata.nextLink,omitempty"`
+	Value    []*SearchFolder `json:"value,omitempty"`
+}
+
+// SearchFolder microsoft mailSearchFolder object, a folder showing the messages of its source folders which match its filter query.
+// Its messages are listed like any other folder's, e.g. Messages().List(searchFolder.ID).
+type SearchFolder struct {
+	ODataType string `json:"@odata.type,omitempty"`
+	Folder
+	IsSupported          bool     `json:"isSupported,omitempty"`
+	IncludeNestedFolders *bool    `json:"includeNestedFolders,omitempty"`
+	SourceFolderIDs      []string `json:"sourceFolderIds,omitempty"`
+	FilterQuery          string   `json:"filterQuery,omitempty"`
 }
 
 // MessageListResult struct representing a response from the outlook messages endpoint
@@ -58,23 +83,250 @@ type MessageListResult struct {
 // Message microsoft message object
 // TODO: Add all fields from outlook
 type Message struct {
-	ID             string       `json:"id,omitempty"`
-	MessageID      string       `json:"internetMessageId,omitempty"`
-	CreatedOn      string       `json:"createdDateTime,omitempty"`
-	ReceivedOn     string       `json:"receivedDateTime,omitempty"`
-	SentOn         string       `json:"sentDateTime,omitempty"`
-	Subject        string       `json:"subject,omitempty"`
-	BodyPreview    string       `json:"bodyPreview,omitempty"`
-	Importance     string       `json:"importance,omitempty"`
-	ConversationID string       `json:"conversationId,omitempty"`
-	IsRead         bool         `json:"isread,omitempty"`
-	Body           *MessageBody `json:"body,omitempty"`
-	Sender         *Recipient   `json:"sender,omitempty"`
-	From           *Recipient   `json:"from,omitempty"`
-	To             []*Recipient `json:"toRecipients,omitempty"`
-	CC             []*Recipient `json:"ccRecipients,omitempty"`
-	BCC            []*Recipient `json:"bccRecipients,omitempty"`
-	ReplyTo        []*Recipient `json:"replyTo,omitempty"`
+	ODataType      string        `json:"@odata.type,omitempty"`
+	ETag           string        `json:"@odata.etag,omitempty"`
+	ID             string        `json:"id,omitempty"`
+	MessageID      string        `json:"internetMessageId,omitempty"`
+	CreatedOn      string        `json:"createdDateTime,omitempty"`
+	ReceivedOn     string        `json:"receivedDateTime,omitempty"`
+	SentOn         string        `json:"sentDateTime,omitempty"`
+	Subject        string        `json:"subject,omitempty"`
+	BodyPreview    string        `json:"bodyPreview,omitempty"`
+	Importance     Importance    `json:"importance,omitempty"`
+	ConversationID string        `json:"conversationId,omitempty"`
+	IsRead         bool          `json:"isread,omitempty"`
+	Body           *MessageBody  `json:"body,omitempty"`
+	Sender         *Recipient    `json:"sender,omitempty"`
+	From           *Recipient    `json:"from,omitempty"`
+	To             []*Recipient  `json:"toRecipients,omitempty"`
+	CC             []*Recipient  `json:"ccRecipients,omitempty"`
+	BCC            []*Recipient  `json:"bccRecipients,omitempty"`
+	ReplyTo        []*Recipient  `json:"replyTo,omitempty"`
+	HasAttachments bool          `json:"hasAttachments,omitempty"`
+	Attachments    []*Attachment `json:"attachments,omitempty"`
+	// InferenceClassification whether the message was routed to the Focused or Other inbox, one of the InferenceClassification constants.
+	InferenceClassification string `json:"inferenceClassification,omitempty"`
+	// MentionsPreview whether the signed in user is @mentioned in the message.
+	MentionsPreview *MentionsPreview `json:"mentionsPreview,omitempty"`
+	// Mentions the message's @mentions, only returned when expanded and only supported by the beta api.
+	Mentions []*Mention `json:"mentions,omitempty"`
+	// MeetingMessageType the kind of meeting message, one of the MeetingMessageType constants, only set on event messages.
+	MeetingMessageType string `json:"meetingMessageType,omitempty"`
+	// UniqueBody the part of the body which is unique to the message, without the quoted messages of its reply chain.
+	// Only returned when selected, e.g. by MessageGetCall.UniqueBody.
+	UniqueBody *MessageBody `json:"uniqueBody,omitempty"`
+	// IsReadReceiptRequested whether the sender asked to be notified when the message is read.
+	IsReadReceiptRequested bool `json:"isReadReceiptRequested,omitempty"`
+	// IsDeliveryReceiptRequested whether the sender asked to be notified when the message is delivered.
+	IsDeliveryReceiptRequested bool `json:"isDeliveryReceiptRequested,omitempty"`
+	// SingleValueExtendedProperties MAPI properties of the message, only returned when expanded, e.g. by MessageListCall.Receipts.
+	SingleValueExtendedProperties []*SingleValueExtendedProperty `json:"singleValueExtendedProperties,omitempty"`
+}
+
+// NewestBody returns the message's unique body, the newest part of its reply chain, if it was read with it, or its full body otherwise.
+func (m *Message) NewestBody() *MessageBody {
+	if m.UniqueBody != nil {
+		return m.UniqueBody
+	}
+	return m.Body
+}
+
+// Message @odata.type values of the event message subtypes graph returns among a mailbox's messages.
+const (
+	MessageODataTypeEventMessage         = "#microsoft.graph.eventMessage"
+	MessageODataTypeEventMessageRequest  = "#microsoft.graph.eventMessageRequest"
+	MessageODataTypeEventMessageResponse = "#microsoft.graph.eventMessageResponse"
+)
+
+// IsEventMessage reports whether the message is a meeting invitation, cancellation, or response rather than a plain email,
+// in which case it can be read in full with MessageService.GetEventMessage.
+func (m *Message) IsEventMessage() bool {
+	switch m.ODataType {
+	case MessageODataTypeEventMessage, MessageODataTypeEventMessageRequest, MessageODataTypeEventMessageResponse:
+		return true
+	}
+	return m.MeetingMessageType != "" && m.MeetingMessageType != MeetingMessageTypeNone
+}
+
+// IsMeetingRequest reports whether the message is an invitation to a meeting, which can be responded to with
+// MessageService.AcceptInvite, DeclineInvite, or TentativelyAcceptInvite.
+func (m *Message) IsMeetingRequest() bool {
+	return m.ODataType == MessageODataTypeEventMessageRequest || m.MeetingMessageType == MeetingMessageTypeRequest
+}
+
+// MentionsPreview microsoft mentionsPreview object
+type MentionsPreview struct {
+	IsMentioned bool `json:"isMentioned"`
+}
+
+// Mention microsoft mention object, an @mention of a user in a message.
+type Mention struct {
+	ID                    string        `json:"id,omitempty"`
+	Mentioned             *EmailAddress `json:"mentioned,omitempty"`
+	MentionText           string        `json:"mentionText,omitempty"`
+	ClientReference       string        `json:"clientReference,omitempty"`
+	CreatedBy             *EmailAddress `json:"createdBy,omitempty"`
+	CreatedDateTime       string        `json:"createdDateTime,omitempty"`
+	ServerCreatedDateTime string        `json:"serverCreatedDateTime,omitempty"`
+	DeepLink              string        `json:"deepLink,omitempty"`
+	Application           string        `json:"application,omitempty"`
+}
+
+// InferenceClassification enum
+const (
+	InferenceClassificationFocused = "focused"
+	InferenceClassificationOther   = "other"
+)
+
+// InferenceClassificationOverrideListResult struct representing a response from the outlook inferenceClassification overrides endpoint
+type InferenceClassificationOverrideListResult struct {
+	Context string                             `json:"@odata.context,omitempty"`
+	Value   []*InferenceClassificationOverride `json:"value,omitempty"`
+}
+
+// InferenceClassificationOverride microsoft inferenceClassificationOverride object, always routing a sender's messages to the Focused or Other inbox.
+type InferenceClassificationOverride struct {
+	ID                 string        `json:"id,omitempty"`
+	ClassifyAs         string        `json:"classifyAs,omitempty"`
+	SenderEmailAddress *EmailAddress `json:"senderEmailAddress,omitempty"`
+}
+
+// ConversationListResult struct representing a response from the outlook group conversations endpoint
+type ConversationListResult struct {
+	Context  string          `json:"@odata.context,omitempty"`
+	NextLink string          `json:"@odata.nextLink,omitempty"`
+	Value    []*Conversation `json:"value,omitempty"`
+}
+
+// Conversation microsoft conversation object, a group email conversation made of one or more threads.
+type Conversation struct {
+	ID                    string                `json:"id,omitempty"`
+	Topic                 string                `json:"topic,omitempty"`
+	Preview               string                `json:"preview,omitempty"`
+	HasAttachments        bool                  `json:"hasAttachments,omitempty"`
+	LastDeliveredDateTime string                `json:"lastDeliveredDateTime,omitempty"`
+	UniqueSenders         []string              `json:"uniqueSenders,omitempty"`
+	Threads               []*ConversationThread `json:"threads,omitempty"`
+}
+
+// ConversationThreadListResult struct representing a response from the outlook group threads endpoint
+type ConversationThreadListResult struct {
+	Context  string                `json:"@odata.context,omitempty"`
+	NextLink string                `json:"@odata.nextLink,omitempty"`
+	Value    []*ConversationThread `json:"value,omitempty"`
+}
+
+// ConversationThread microsoft conversationThread object, a thread of posts with a single set of recipients within a group conversation.
+type ConversationThread struct {
+	ID                    string       `json:"id,omitempty"`
+	Topic                 string       `json:"topic,omitempty"`
+	Preview               string       `json:"preview,omitempty"`
+	HasAttachments        bool         `json:"hasAttachments,omitempty"`
+	IsLocked              bool         `json:"isLocked,omitempty"`
+	LastDeliveredDateTime string       `json:"lastDeliveredDateTime,omitempty"`
+	UniqueSenders         []string     `json:"uniqueSenders,omitempty"`
+	To                    []*Recipient `json:"toRecipients,omitempty"`
+	CC                    []*Recipient `json:"ccRecipients,omitempty"`
+	Posts                 []*Post      `json:"posts,omitempty"`
+}
+
+// PostListResult struct representing a response from the outlook group thread posts endpoint
+type PostListResult struct {
+	Context  string  `json:"@odata.context,omitempty"`
+	NextLink string  `json:"@odata.nextLink,omitempty"`
+	Value    []*Post `json:"value,omitempty"`
+}
+
+// Post microsoft post object, a single message in a group conversation thread.
+type Post struct {
+	ID                   string        `json:"id,omitempty"`
+	ChangeKey            string        `json:"changeKey,omitempty"`
+	ConversationID       string        `json:"conversationId,omitempty"`
+	ConversationThreadID string        `json:"conversationThreadId,omitempty"`
+	Body                 *MessageBody  `json:"body,omitempty"`
+	From                 *Recipient    `json:"from,omitempty"`
+	Sender               *Recipient    `json:"sender,omitempty"`
+	NewParticipants      []*Recipient  `json:"newParticipants,omitempty"`
+	HasAttachments       bool          `json:"hasAttachments,omitempty"`
+	Attachments          []*Attachment `json:"attachments,omitempty"`
+	Categories           []string      `json:"categories,omitempty"`
+	ReceivedOn           string        `json:"receivedDateTime,omitempty"`
+	CreatedOn            string        `json:"createdDateTime,omitempty"`
+	UpdatedOn            string        `json:"lastModifiedDateTime,omitempty"`
+}
+
+// AttachmentODataType enum
+const (
+	AttachmentODataTypeFile      = "#microsoft.graph.fileAttachment"
+	AttachmentODataTypeItem      = "#microsoft.graph.itemAttachment"
+	AttachmentODataTypeReference = "#microsoft.graph.referenceAttachment"
+)
+
+// Attachment microsoft attachment object. File attachments carry their content in ContentBytes, which encoding/json base64 encodes.
+type Attachment struct {
+	ODataType    string `json:"@odata.type,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	ContentType  string `json:"contentType,omitempty"`
+	Size         int    `json:"size,omitempty"`
+	IsInline     bool   `json:"isInline,omitempty"`
+	ContentID    string `json:"contentId,omitempty"`
+	ContentBytes []byte `json:"contentBytes,omitempty"`
+}
+
+// MeetingMessageType enum
+const (
+	MeetingMessageTypeNone                = "none"
+	MeetingMessageTypeRequest             = "meetingRequest"
+	MeetingMessageTypeCancelled           = "meetingCancelled"
+	MeetingMessageTypeAccepted            = "meetingAccepted"
+	MeetingMessageTypeTentativelyAccepted = "meetingTenativelyAccepted"
+	MeetingMessageTypeDeclined            = "meetingDeclined"
+)
+
+// EventMessage microsoft eventMessage object, a message about a meeting such as an invitation or cancellation.
+type EventMessage struct {
+	Message
+	Start       *DateTimeTimeZone    `json:"startDateTime,omitempty"`
+	End         *DateTimeTimeZone    `json:"endDateTime,omitempty"`
+	Location    *Location            `json:"location,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Recurrence  *PatternedRecurrence `json:"recurrence,omitempty"`
+	IsAllDay    bool                 `json:"isAllDay,omitempty"`
+	IsDelegated bool                 `json:"isDelegated,omitempty"`
+	// IsOutOfDate whether the meeting changed since the message was sent, e.g. an invitation superseded by an update.
+	IsOutOfDate bool `json:"isOutOfDate,omitempty"`
+	// Event the meeting in the user's calendar, only returned when expanded.
+	Event *Event `json:"event,omitempty"`
+}
+
+// MeetingRequestType enum
+const (
+	MeetingRequestTypeNone                = "none"
+	MeetingRequestTypeNewMeetingRequest   = "newMeetingRequest"
+	MeetingRequestTypeFullUpdate          = "fullUpdate"
+	MeetingRequestTypeInformationalUpdate = "informationalUpdate"
+	MeetingRequestTypeSilentUpdate        = "silentUpdate"
+	MeetingRequestTypeOutdated            = "outdated"
+	MeetingRequestTypePrincipalWantsCopy  = "principalWantsCopy"
+)
+
+// EventMessageRequest microsoft eventMessageRequest object, an invitation to a meeting or an update to one.
+type EventMessageRequest struct {
+	EventMessage
+	MeetingRequestType    string            `json:"meetingRequestType,omitempty"`
+	ResponseRequested     bool              `json:"responseRequested,omitempty"`
+	AllowNewTimeProposals bool              `json:"allowNewTimeProposals,omitempty"`
+	PreviousStart         *DateTimeTimeZone `json:"previousStartDateTime,omitempty"`
+	PreviousEnd           *DateTimeTimeZone `json:"previousEndDateTime,omitempty"`
+	PreviousLocation      *Location         `json:"previousLocation,omitempty"`
+}
+
+// EventMessageResponse microsoft eventMessageResponse object, received by an organizer when an attendee responds to an invitation.
+type EventMessageResponse struct {
+	EventMessage
+	ResponseType    string    `json:"responseType,omitempty"`
+	ProposedNewTime *TimeSlot `json:"proposedNewTime,omitempty"`
 }
 
 // BodyContentType enum
@@ -108,17 +360,52 @@ type CalendarListResult struct {
 	Value    []*Calendar `json:"value,omitempty"`
 }
 
+// CalendarColor enum
+const (
+	CalendarColorAuto        = "auto"
+	CalendarColorLightBlue   = "lightBlue"
+	CalendarColorLightGreen  = "lightGreen"
+	CalendarColorLightOrange = "lightOrange"
+	CalendarColorLightGray   = "lightGray"
+	CalendarColorLightYellow = "lightYellow"
+	CalendarColorLightTeal   = "lightTeal"
+	CalendarColorLightPink   = "lightPink"
+	CalendarColorLightBrown  = "lightBrown"
+	CalendarColorLightRed    = "lightRed"
+	CalendarColorMaxColor    = "maxColor"
+)
+
 // Calendar outlook calendar object
 type Calendar struct {
+	ETag                string        `json:"@odata.etag,omitempty"`
 	ID                  string        `json:"id,omitempty"`
 	Name                string        `json:"name,omitempty"`
 	Color               string        `json:"color,omitempty"`
+	HexColor            string        `json:"hexColor,omitempty"`
 	CanShare            bool          `json:"canShare,omitempty"`
 	CanViewPrivateItems bool          `json:"canViewPrivateItems,omitempty"`
 	CanEdit             bool          `json:"canEdit,omitempty"`
+	IsDefaultCalendar   bool          `json:"isDefaultCalendar,omitempty"`
+	IsRemovable         bool          `json:"isRemovable,omitempty"`
+	ChangeKey           string        `json:"changeKey,omitempty"`
 	Owner               *EmailAddress `json:"owner,omitempty"`
 }
 
+// CalendarGroupListResult struct representing a response from the outlook calendarGroups endpoint
+type CalendarGroupListResult struct {
+	Context  string           `json:"@odata.context,omitempty"`
+	NextLink string           `json:"@odata.nextLink,omitempty"`
+	Value    []*CalendarGroup `json:"value,omitempty"`
+}
+
+// CalendarGroup outlook calendar group object
+type CalendarGroup struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	ClassID   string `json:"classId,omitempty"`
+	ChangeKey string `json:"changeKey,omitempty"`
+}
+
 // EventListResult you can tell by the way it is
 type EventListResult struct {
 	Context  string   `json:"@odata.context,omitempty"`
@@ -127,6 +414,24 @@ type EventListResult struct {
 	Value    []*Event `json:"value,omitempty"`
 }
 
+// ReminderListResult struct representing a response from the outlook reminderView endpoint
+type ReminderListResult struct {
+	Context string      `json:"@odata.context,omitempty"`
+	Value   []*Reminder `json:"value,omitempty"`
+}
+
+// Reminder microsoft reminder object
+type Reminder struct {
+	EventID          string            `json:"eventId,omitempty"`
+	ChangeKey        string            `json:"changeKey,omitempty"`
+	EventSubject     string            `json:"eventSubject,omitempty"`
+	EventStart       *DateTimeTimeZone `json:"eventStartTime,omitempty"`
+	EventEnd         *DateTimeTimeZone `json:"eventEndTime,omitempty"`
+	EventLocation    *Location         `json:"eventLocation,omitempty"`
+	EventWebLink     string            `json:"eventWebLink,omitempty"`
+	ReminderFireTime *DateTimeTimeZone `json:"reminderFireTime,omitempty"`
+}
+
 // Essentially, enums of possible values for outlook calendar events. Would like to change to iota+custom json serializer/deserializer.
 const (
 	// EventShowAs
@@ -143,21 +448,22 @@ const (
 	EventTypeException      = "exception"
 	EventTypeSeriesMaster   = "seriesMaster"
 
-	// EventSensitivity
-	EventSensitivityNormal       = "normal"
-	EventSensitivityPersonal     = "personal"
-	EventSensitivityPrivate      = "private"
-	EventSensitivityConfidential = "confidential"
+	// EventSensitivity, kept for compatibility, see Sensitivity
+	EventSensitivityNormal       = SensitivityNormal
+	EventSensitivityPersonal     = SensitivityPersonal
+	EventSensitivityPrivate      = SensitivityPrivate
+	EventSensitivityConfidential = SensitivityConfidential
 
-	// EventImportance
-	EventImportanceLow    = "low"
-	EventImportanceNormal = "normal"
-	EventImportanceHigh   = "high"
+	// EventImportance, kept for compatibility, see Importance
+	EventImportanceLow    = ImportanceLow
+	EventImportanceNormal = ImportanceNormal
+	EventImportanceHigh   = ImportanceHigh
 )
 
 // Event microsoft event object
 // TODO: Add all fields from outlook
 type Event struct {
+	ETag                       string               `json:"@odata.etag,omitempty"`
 	ID                         string               `json:"id,omitempty"`
 	CreatedOn                  string               `json:"createdDateTime,omitempty"`
 	UpdatedOn                  string               `json:"lastModifiedDateTime,omitempty"`
@@ -165,7 +471,7 @@ type Event struct {
 	Categories                 []string             `json:"categories,omitempty"`
 	Subject                    string               `json:"subject,omitempty"`
 	BodyPreview                string               `json:"bodyPreview,omitempty"`
-	Importance                 string               `json:"importance,omitempty"`
+	Importance                 Importance           `json:"importance,omitempty"`
 	IsOrganizer                bool                 `json:"isOrganizer,omitempty"`
 	IsCancelled                bool                 `json:"isCancelled,omitempty"`
 	SeriesID                   string               `json:"seriesMasterId,omitempty"`
@@ -183,15 +489,71 @@ type Event struct {
 	ResponseStatus             *ResponseStatus      `json:"responseStatus,omitempty"`
 	WebLink                    string               `json:"webLink,omitempty"`
 	OnlineMeetingURL           string               `json:"onlineMeetingUrl,omitempty"`
+	IsOnlineMeeting            bool                 `json:"isOnlineMeeting,omitempty"`
+	OnlineMeetingProvider      string               `json:"onlineMeetingProvider,omitempty"`
+	OnlineMeeting              *OnlineMeetingInfo   `json:"onlineMeeting,omitempty"`
 	ShowAs                     string               `json:"showAs,omitempty"`
-	Sensitivity                string               `json:"sensitivity,omitempty"`
+	Sensitivity                Sensitivity          `json:"sensitivity,omitempty"`
 	ResponseRequested          bool                 `json:"responseRequested,omitempty"`
 	ReminderMinutesBeforeStart int                  `json:"reminderMinutesBeforeStart,omitempty"`
 	Recurrence                 *PatternedRecurrence `json:"recurrence,omitempty"`
 	ReminderOn                 bool                 `json:"isReminderOn,omitempty"`
 	HasAttachments             bool                 `json:"hasAttachments,omitempty"`
+	// TransactionID an identifier of the caller's choosing, set on create, which graph uses to not create the same event twice when a create is retried.
+	TransactionID string `json:"transactionId,omitempty"`
+	// SingleValueExtendedProperties custom MAPI properties of the event, only returned when expanded.
+	SingleValueExtendedProperties []*SingleValueExtendedProperty `json:"singleValueExtendedProperties,omitempty"`
+	// Removed set on events returned by a delta query which were deleted, or moved out of its window, since the previous round.
+	Removed *Removed `json:"@removed,omitempty"`
+}
+
+// SingleValueExtendedProperty microsoft singleValueLegacyExtendedProperty object, a MAPI property graph has no first class property for.
+type SingleValueExtendedProperty struct {
+	// ID the property's type and name or tag, e.g. "String {00020329-0000-0000-C000-000000000046} Name x-my-property".
+	ID    string `json:"id,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// Removed marks an item returned by a delta query as removed rather than created or updated.
+type Removed struct {
+	// Reason why the item was removed, "deleted" or "changed".
+	Reason string `json:"reason,omitempty"`
+}
+
+// OnlineMeetingProvider enum
+const (
+	OnlineMeetingProviderTeamsForBusiness = "teamsForBusiness"
+	OnlineMeetingProviderSkypeForBusiness = "skypeForBusiness"
+	OnlineMeetingProviderSkypeForConsumer = "skypeForConsumer"
+	OnlineMeetingProviderUnknown          = "unknown"
+)
+
+// OnlineMeetingInfo microsoft details for joining an online meeting.
+type OnlineMeetingInfo struct {
+	JoinURL         string   `json:"joinUrl,omitempty"`
+	ConferenceID    string   `json:"conferenceId,omitempty"`
+	TollNumber      string   `json:"tollNumber,omitempty"`
+	TollFreeNumbers []string `json:"tollFreeNumbers,omitempty"`
+	QuickDial       string   `json:"quickDial,omitempty"`
+	Phones          []*Phone `json:"phones,omitempty"`
+}
+
+// Phone microsoft phone number object
+type Phone struct {
+	Number string `json:"number,omitempty"`
+	Type   string `json:"type,omitempty"`
 }
 
+// ResponseType enum, an attendee's response to an event
+const (
+	ResponseTypeNone                = "none"
+	ResponseTypeOrganizer           = "organizer"
+	ResponseTypeTentativelyAccepted = "tentativelyAccepted"
+	ResponseTypeAccepted            = "accepted"
+	ResponseTypeDeclined            = "declined"
+	ResponseTypeNotResponded        = "notResponded"
+)
+
 // ResponseStatus something
 type ResponseStatus struct {
 	Response string `json:"response,omitempty"`
@@ -204,6 +566,12 @@ type DateTimeTimeZone struct {
 	Timezone string `json:"timeZone,omitempty"`
 }
 
+// TimeSlot microsoft time slot object
+type TimeSlot struct {
+	Start *DateTimeTimeZone `json:"start,omitempty"`
+	End   *DateTimeTimeZone `json:"end,omitempty"`
+}
+
 // Location microsoft event location object
 type Location struct {
 	DisplayName string   `json:"displayName,omitempty"`
@@ -220,6 +588,13 @@ type Address struct {
 	Postal  string `json:"postalCode,omitempty"`
 }
 
+// AttendeeType enum
+const (
+	AttendeeTypeRequired = "required"
+	AttendeeTypeOptional = "optional"
+	AttendeeTypeResource = "resource"
+)
+
 // Attendee microsoft event attendee object
 type Attendee struct {
 	Type         string          `json:"type,omitempty"`
@@ -249,6 +624,15 @@ const (
 	RecurrencePatternIndexThird  = "third"
 	RecurrencePatternIndexFourth = "fourth"
 	RecurrencePatternIndexLast   = "last"
+
+	// DayOfWeek
+	DayOfWeekSunday    = "sunday"
+	DayOfWeekMonday    = "monday"
+	DayOfWeekTuesday   = "tuesday"
+	DayOfWeekWednesday = "wednesday"
+	DayOfWeekThursday  = "thursday"
+	DayOfWeekFriday    = "friday"
+	DayOfWeekSaturday  = "saturday"
 )
 
 // RecurrencePattern microsoft event recurrence pattern definition.
@@ -277,3 +661,283 @@ type RecurrenceRange struct {
 	StartDate           string `json:"startDate,omitempty"`
 	Type                string `json:"type,omitempty"`
 }
+
+// ActivityDomain enum
+const (
+	ActivityDomainWork         = "work"
+	ActivityDomainPersonal     = "personal"
+	ActivityDomainUnrestricted = "unrestricted"
+	ActivityDomainUnknown      = "unknown"
+)
+
+// TimeConstraint microsoft restrictions on when a meeting may be scheduled.
+type TimeConstraint struct {
+	ActivityDomain string      `json:"activityDomain,omitempty"`
+	TimeSlots      []*TimeSlot `json:"timeSlots,omitempty"`
+}
+
+// LocationConstraint microsoft restrictions on where a meeting may be held.
+type LocationConstraint struct {
+	IsRequired      bool                      `json:"isRequired"`
+	SuggestLocation bool                      `json:"suggestLocation"`
+	Locations       []*LocationConstraintItem `json:"locations,omitempty"`
+}
+
+// LocationConstraintItem microsoft candidate location for a meeting.
+type LocationConstraintItem struct {
+	Location
+	LocationEmailAddress string `json:"locationEmailAddress,omitempty"`
+	ResolveAvailability  bool   `json:"resolveAvailability"`
+}
+
+// MeetingTimeSuggestionsResult struct representing a response from the outlook findMeetingTimes endpoint
+type MeetingTimeSuggestionsResult struct {
+	MeetingTimeSuggestions []*MeetingTimeSuggestion `json:"meetingTimeSuggestions,omitempty"`
+	EmptySuggestionsReason string                   `json:"emptySuggestionsReason,omitempty"`
+}
+
+// MeetingTimeSuggestion microsoft suggested meeting time, ranked by Order.
+type MeetingTimeSuggestion struct {
+	Confidence            float64                 `json:"confidence,omitempty"`
+	Order                 int                     `json:"order,omitempty"`
+	OrganizerAvailability string                  `json:"organizerAvailability,omitempty"`
+	SuggestionReason      string                  `json:"suggestionReason,omitempty"`
+	MeetingTimeSlot       *TimeSlot               `json:"meetingTimeSlot,omitempty"`
+	AttendeeAvailability  []*AttendeeAvailability `json:"attendeeAvailability,omitempty"`
+	Locations             []*Location             `json:"locations,omitempty"`
+}
+
+// AttendeeAvailability microsoft availability of an attendee for a suggested meeting time.
+type AttendeeAvailability struct {
+	Attendee     *Attendee `json:"attendee,omitempty"`
+	Availability string    `json:"availability,omitempty"`
+}
+
+// Availability enum, the characters which make up an availabilityView string
+const (
+	AvailabilityFree             = '0'
+	AvailabilityTentative        = '1'
+	AvailabilityBusy             = '2'
+	AvailabilityOOF              = '3'
+	AvailabilityWorkingElsewhere = '4'
+)
+
+// ScheduleListResult struct representing a response from the outlook getSchedule endpoint
+type ScheduleListResult struct {
+	Context string                 `json:"@odata.context,omitempty"`
+	Value   []*ScheduleInformation `json:"value,omitempty"`
+}
+
+// ScheduleInformation microsoft free/busy information for a user, group, or resource.
+type ScheduleInformation struct {
+	ScheduleID       string          `json:"scheduleId,omitempty"`
+	AvailabilityView string          `json:"availabilityView,omitempty"`
+	ScheduleItems    []*ScheduleItem `json:"scheduleItems,omitempty"`
+	WorkingHours     *WorkingHours   `json:"workingHours,omitempty"`
+	Error            *FreeBusyError  `json:"error,omitempty"`
+}
+
+// ScheduleItem microsoft busy block on a schedule.
+type ScheduleItem struct {
+	Status    string            `json:"status,omitempty"`
+	Subject   string            `json:"subject,omitempty"`
+	Location  string            `json:"location,omitempty"`
+	IsPrivate bool              `json:"isPrivate,omitempty"`
+	Start     *DateTimeTimeZone `json:"start,omitempty"`
+	End       *DateTimeTimeZone `json:"end,omitempty"`
+}
+
+// WorkingHours microsoft working hours of a user.
+type WorkingHours struct {
+	DaysOfWeek []string      `json:"daysOfWeek,omitempty"`
+	StartTime  string        `json:"startTime,omitempty"`
+	EndTime    string        `json:"endTime,omitempty"`
+	TimeZone   *TimeZoneBase `json:"timeZone,omitempty"`
+}
+
+// TimeZoneBase microsoft time zone object
+type TimeZoneBase struct {
+	Name string `json:"name,omitempty"`
+}
+
+// FreeBusyError microsoft error returned for a schedule which could not be read.
+type FreeBusyError struct {
+	Message      string `json:"message,omitempty"`
+	ResponseCode string `json:"responseCode,omitempty"`
+}
+
+// RoomListResult struct representing a response from the outlook places room endpoint
+type RoomListResult struct {
+	Context  string  `json:"@odata.context,omitempty"`
+	NextLink string  `json:"@odata.nextLink,omitempty"`
+	Value    []*Room `json:"value,omitempty"`
+}
+
+// Room microsoft room object, a bookable meeting space.
+type Room struct {
+	ID                     string          `json:"id,omitempty"`
+	DisplayName            string          `json:"displayName,omitempty"`
+	EmailAddress           string          `json:"emailAddress,omitempty"`
+	Nickname               string          `json:"nickname,omitempty"`
+	Label                  string          `json:"label,omitempty"`
+	Capacity               int             `json:"capacity,omitempty"`
+	Building               string          `json:"building,omitempty"`
+	FloorNumber            int             `json:"floorNumber,omitempty"`
+	FloorLabel             string          `json:"floorLabel,omitempty"`
+	BookingType            string          `json:"bookingType,omitempty"`
+	AudioDeviceName        string          `json:"audioDeviceName,omitempty"`
+	VideoDeviceName        string          `json:"videoDeviceName,omitempty"`
+	DisplayDeviceName      string          `json:"displayDeviceName,omitempty"`
+	IsWheelChairAccessible bool            `json:"isWheelChairAccessible,omitempty"`
+	Tags                   []string        `json:"tags,omitempty"`
+	Phone                  string          `json:"phone,omitempty"`
+	Address                *Address        `json:"address,omitempty"`
+	GeoCoordinates         *GeoCoordinates `json:"geoCoordinates,omitempty"`
+}
+
+// RoomListListResult struct representing a response from the outlook places roomList endpoint
+type RoomListListResult struct {
+	Context  string      `json:"@odata.context,omitempty"`
+	NextLink string      `json:"@odata.nextLink,omitempty"`
+	Value    []*RoomList `json:"value,omitempty"`
+}
+
+// RoomList microsoft roomList object, a named group of rooms such as those in one building.
+type RoomList struct {
+	ID           string   `json:"id,omitempty"`
+	DisplayName  string   `json:"displayName,omitempty"`
+	EmailAddress string   `json:"emailAddress,omitempty"`
+	Phone        string   `json:"phone,omitempty"`
+	Address      *Address `json:"address,omitempty"`
+}
+
+// GeoCoordinates microsoft geographic coordinates object
+type GeoCoordinates struct {
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	Altitude  float64 `json:"altitude,omitempty"`
+}
+
+// CalendarRoleType enum
+const (
+	CalendarRoleNone                              = "none"
+	CalendarRoleFreeBusyRead                      = "freeBusyRead"
+	CalendarRoleLimitedRead                       = "limitedRead"
+	CalendarRoleRead                              = "read"
+	CalendarRoleWrite                             = "write"
+	CalendarRoleDelegateWithoutPrivateEventAccess = "delegateWithoutPrivateEventAccess"
+	CalendarRoleDelegateWithPrivateEventAccess    = "delegateWithPrivateEventAccess"
+	CalendarRoleCustom                            = "custom"
+)
+
+// CalendarPermissionListResult struct representing a response from the outlook calendarPermissions endpoint
+type CalendarPermissionListResult struct {
+	Context string                `json:"@odata.context,omitempty"`
+	Value   []*CalendarPermission `json:"value,omitempty"`
+}
+
+// CalendarPermission microsoft calendarPermission object, the access a user or group has been granted to a calendar.
+type CalendarPermission struct {
+	ID                   string        `json:"id,omitempty"`
+	EmailAddress         *EmailAddress `json:"emailAddress,omitempty"`
+	IsRemovable          bool          `json:"isRemovable,omitempty"`
+	IsInsideOrganization bool          `json:"isInsideOrganization,omitempty"`
+	Role                 string        `json:"role,omitempty"`
+	AllowedRoles         []string      `json:"allowedRoles,omitempty"`
+}
+
+// TrendingListResult struct representing a response from the outlook insights trending endpoint
+type TrendingListResult struct {
+	Context  string      `json:"@odata.context,omitempty"`
+	NextLink string      `json:"@odata.nextLink,omitempty"`
+	Value    []*Trending `json:"value,omitempty"`
+}
+
+// Trending microsoft trending object, a document trending around the user, i.e. popular with the people they work with.
+type Trending struct {
+	ID                    string                 `json:"id,omitempty"`
+	Weight                float64                `json:"weight,omitempty"`
+	LastModifiedOn        string                 `json:"lastModifiedDateTime,omitempty"`
+	ResourceVisualization *ResourceVisualization `json:"resourceVisualization,omitempty"`
+	ResourceReference     *ResourceReference     `json:"resourceReference,omitempty"`
+}
+
+// UsedInsightListResult struct representing a response from the outlook insights used endpoint
+type UsedInsightListResult struct {
+	Context  string         `json:"@odata.context,omitempty"`
+	NextLink string         `json:"@odata.nextLink,omitempty"`
+	Value    []*UsedInsight `json:"value,omitempty"`
+}
+
+// UsedInsight microsoft usedInsight object, a document the user viewed or modified.
+type UsedInsight struct {
+	ID                    string                 `json:"id,omitempty"`
+	LastUsed              *UsageDetails          `json:"lastUsed,omitempty"`
+	ResourceVisualization *ResourceVisualization `json:"resourceVisualization,omitempty"`
+	ResourceReference     *ResourceReference     `json:"resourceReference,omitempty"`
+}
+
+// UsageDetails when a used document was last accessed and modified by the user.
+type UsageDetails struct {
+	LastAccessedOn string `json:"lastAccessedDateTime,omitempty"`
+	LastModifiedOn string `json:"lastModifiedDateTime,omitempty"`
+}
+
+// SharedInsightListResult struct representing a response from the outlook insights shared endpoint
+type SharedInsightListResult struct {
+	Context  string           `json:"@odata.context,omitempty"`
+	NextLink string           `json:"@odata.nextLink,omitempty"`
+	Value    []*SharedInsight `json:"value,omitempty"`
+}
+
+// SharedInsight microsoft sharedInsight object, a document shared with or by the user, as an email attachment or a link.
+type SharedInsight struct {
+	ID                    string                 `json:"id,omitempty"`
+	LastShared            *SharingDetail         `json:"lastShared,omitempty"`
+	SharingHistory        []*SharingDetail       `json:"sharingHistory,omitempty"`
+	ResourceVisualization *ResourceVisualization `json:"resourceVisualization,omitempty"`
+	ResourceReference     *ResourceReference     `json:"resourceReference,omitempty"`
+}
+
+// SharingType enum
+const (
+	// SharingTypeAttachment the document was shared as an email attachment
+	SharingTypeAttachment = "Attachment"
+	// SharingTypeLink the document was shared as a link, in email or otherwise
+	SharingTypeLink = "Link"
+)
+
+// SharingDetail a single time a document was shared.
+type SharingDetail struct {
+	SharedOn         string             `json:"sharedDateTime,omitempty"`
+	SharingSubject   string             `json:"sharingSubject,omitempty"`
+	SharingType      string             `json:"sharingType,omitempty"`
+	SharedBy         *InsightIdentity   `json:"sharedBy,omitempty"`
+	SharingReference *ResourceReference `json:"sharingReference,omitempty"`
+}
+
+// InsightIdentity the person who shared a document.
+type InsightIdentity struct {
+	ID          string `json:"id,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Address     string `json:"address,omitempty"`
+}
+
+// ResourceVisualization how to display the document an insight is about, e.g. in a list of recent files.
+type ResourceVisualization struct {
+	Title                string `json:"title,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	MediaType            string `json:"mediaType,omitempty"`
+	PreviewImageURL      string `json:"previewImageUrl,omitempty"`
+	PreviewText          string `json:"previewText,omitempty"`
+	ContainerWebURL      string `json:"containerWebUrl,omitempty"`
+	ContainerDisplayName string `json:"containerDisplayName,omitempty"`
+	ContainerType        string `json:"containerType,omitempty"`
+}
+
+// ResourceReference the document an insight is about, or the item, such as an email, it was shared in.
+type ResourceReference struct {
+	ID     string `json:"id,omitempty"`
+	Type   string `json:"type,omitempty"`
+	WebURL string `json:"webUrl,omitempty"`
+}
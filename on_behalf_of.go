@@ -0,0 +1,55 @@
+package outlook
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	jwtBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+type onBehalfOfTokenSource struct {
+	ctx           context.Context
+	tokenURL      string
+	clientID      string
+	clientSecret  string
+	userAssertion string
+	scopes        []string
+}
+
+// NewOnBehalfOfTokenSource returns a TokenSource which exchanges userAssertion, the access token a middle-tier api received from its own frontend,
+// for a graph token acting on behalf of the same user. scopes defaults to DefaultAppScope when empty, requesting every delegated permission consented to for the api.
+func NewOnBehalfOfTokenSource(ctx context.Context, tenant, clientID, clientSecret, userAssertion string, scopes []string, opts ...AuthOpt) oauth2.TokenSource {
+	options := newAuthOptions(opts)
+	if len(scopes) == 0 {
+		scopes = []string{options.appScope}
+	}
+	return NewReuseTokenSource(nil, &onBehalfOfTokenSource{
+		ctx:           ctx,
+		tokenURL:      options.tenantAuthority(tenant).TokenURL(),
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		userAssertion: userAssertion,
+		scopes:        scopes,
+	})
+}
+
+func (obo *onBehalfOfTokenSource) Token() (*oauth2.Token, error) {
+	return obo.TokenContext(obo.ctx)
+}
+
+func (obo *onBehalfOfTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	form := url.Values{
+		"grant_type":          {jwtBearerGrantType},
+		"client_id":           {obo.clientID},
+		"client_secret":       {obo.clientSecret},
+		"assertion":           {obo.userAssertion},
+		"scope":               {strings.Join(obo.scopes, " ")},
+		"requested_token_use": {"on_behalf_of"},
+	}
+	return postTokenForm(withTokenHTTPClient(ctx, obo.ctx), obo.tokenURL, form)
+}
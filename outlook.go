@@ -3,16 +3,20 @@ package outlook
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -22,11 +26,22 @@ const (
 	DefaultBaseURL = "https://graph.microsoft.com/v1.0"
 	// DefaultOAuthTokenURL the url used to exchange a user's refreshToken for a usable accessToken
 	DefaultOAuthTokenURL = "https://login.microsoftonline.com/common/oauth2/v2.0/token"
-	// DefaultAuthScopes the set of permissions the client will request from the user
+	// DefaultOAuthAuthorizeURL the url users are sent to in order to sign in and consent to the client's scopes
+	DefaultOAuthAuthorizeURL = "https://login.microsoftonline.com/common/oauth2/v2.0/authorize"
+	// DefaultAuthScopes the set of permissions the client will request from the user, see DefaultScopes for the typed equivalent
 	DefaultAuthScopes = "mail.read calendars.read user.read offline_access"
+	// DefaultAppScope the scope requested by app-only clients, granting every application permission consented to in the tenant
+	DefaultAppScope = "https://graph.microsoft.com/.default"
+	// DefaultAuthorityHost the host of the microsoft identity platform
+	DefaultAuthorityHost = "https://login.microsoftonline.com"
 	// DefaultQueryDateTimeFormat time format for the datetime query parameters used in outlook
 	DefaultQueryDateTimeFormat = "2006-01-02T15:04:05Z"
 
+	// APIVersionV1 the generally available version of the graph api
+	APIVersionV1 = "v1.0"
+	// APIVersionBeta the preview version of the graph api, exposing features not yet available in v1.0
+	APIVersionBeta = "beta"
+
 	mediaType = "application/json"
 )
 
@@ -39,6 +54,9 @@ var (
 
 	// DefaultUserAgent the user agent to get passed in request headers on each call
 	DefaultUserAgent = fmt.Sprintf("go-outlook/%s", ClientVersion)
+
+	// DefaultSDKVersion the value of the SdkVersion telemetry header graph sdks identify themselves with
+	DefaultSDKVersion = fmt.Sprintf("go-outlook/%s", ClientVersion)
 )
 
 // Client manages communication with microsoft's graph api, specifically for Mail and Calendar.
@@ -48,6 +66,20 @@ type Client struct {
 	userAgent   string
 	mediaType   string
 	tokenSource oauth2.TokenSource
+	middleware  []Middleware
+	logger      Logger
+
+	tracerProvider trace.TracerProvider
+	metrics        MetricsRecorder
+	dump           *wireDumper
+	mailboxLimiter *mailboxLimiter
+	rateLimiter    *rate.Limiter
+	breaker        *circuitBreaker
+	folderIDs      *folderIDCache
+	compressAbove  int
+	header         http.Header
+	dryRun         bool
+	optErr         error
 }
 
 // ClientOpt functions to configure options on a Client.
@@ -67,6 +99,44 @@ func SetClientTokenSource(tokenSource oauth2.TokenSource) ClientOpt {
 	}
 }
 
+// SetClientAPIVersion returns a ClientOpt function which sets the graph api version the client calls, e.g. APIVersionBeta.
+func SetClientAPIVersion(version string) ClientOpt {
+	return func(c *Client) {
+		c.baseURL = c.versionedBaseURL(version)
+	}
+}
+
+// SetClientUserAgentSuffix returns a ClientOpt function which appends an application identifier, e.g. "contoso-sync/2.3", to the user agent,
+// so tenant admins and microsoft support can attribute traffic to the application rather than just this sdk.
+func SetClientUserAgentSuffix(suffix string) ClientOpt {
+	return func(c *Client) {
+		c.userAgent = fmt.Sprintf("%s %s", c.userAgent, suffix)
+	}
+}
+
+// SetClientHeader returns a ClientOpt function which sends the given header on every request, e.g. to override the SdkVersion telemetry header.
+func SetClientHeader(key, value string) ClientOpt {
+	return func(c *Client) {
+		c.header.Set(key, value)
+	}
+}
+
+// SetClientBaseURL returns a ClientOpt function which sets the url requests are sent to, e.g. a mock server, proxy, or national cloud
+// such as "https://graph.microsoft.us/v1.0". A trailing slash is ignored. NewClient fails if baseURL is not an absolute url.
+func SetClientBaseURL(baseURL string) ClientOpt {
+	return func(c *Client) {
+		parsed, err := url.Parse(strings.TrimRight(baseURL, "/"))
+		if err == nil && (parsed.Scheme == "" || parsed.Host == "") {
+			err = fmt.Errorf("base url %q is not absolute", baseURL)
+		}
+		if err != nil {
+			c.optErr = err
+			return
+		}
+		c.baseURL = parsed
+	}
+}
+
 // NewClient returns a new instance of a Client with the given options set.
 func NewClient(opts ...ClientOpt) (*Client, error) {
 	baseURL, err := url.Parse(DefaultBaseURL)
@@ -74,14 +144,21 @@ func NewClient(opts ...ClientOpt) (*Client, error) {
 		return nil, err
 	}
 	client := &Client{
-		client:    DefaultClient,
-		baseURL:   baseURL,
-		userAgent: DefaultUserAgent,
-		mediaType: mediaType,
+		client:         DefaultClient,
+		baseURL:        baseURL,
+		userAgent:      DefaultUserAgent,
+		mediaType:      mediaType,
+		mailboxLimiter: newMailboxLimiter(DefaultMailboxConcurrency),
+		folderIDs:      &folderIDCache{},
+		header:         http.Header{},
 	}
+	client.header.Set("SdkVersion", DefaultSDKVersion)
 	for _, opt := range opts {
 		opt(client)
 	}
+	if client.optErr != nil {
+		return nil, client.optErr
+	}
 	return client, nil
 }
 
@@ -91,7 +168,20 @@ func (client *Client) SetMediaType(mType string) *Client {
 	return client
 }
 
-// NewRequest creates a new request with some reasonable defaults based on the client.
+// versionedBaseURL returns the client's base url with its trailing version segment replaced by version,
+// or with version appended when the base url, e.g. a mock server's, has no version segment.
+func (client *Client) versionedBaseURL(version string) *url.URL {
+	versioned := *client.baseURL
+	trimmed := strings.TrimSuffix(versioned.Path, "/")
+	switch path.Base(trimmed) {
+	case APIVersionV1, APIVersionBeta:
+		trimmed = path.Dir(trimmed)
+	}
+	versioned.Path = path.Join("/", trimmed, version)
+	return &versioned
+}
+
+// NewRequest creates a new request with some reasonable defaults based on the client, bound to ctx.
 func (client *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	var fullURL string
 	pathURL, err := url.Parse(path)
@@ -104,8 +194,12 @@ func (client *Client) NewRequest(ctx context.Context, method, path string, body
 		fullURL = fmt.Sprintf("%s%s", client.baseURL.String(), path)
 	}
 
+	contentType := client.mediaType
 	encodedBody := new(bytes.Buffer)
-	if body != nil {
+	if raw, ok := body.(mimeBody); ok {
+		contentType = "text/plain"
+		encodedBody.WriteString(base64.StdEncoding.EncodeToString(raw))
+	} else if body != nil {
 		switch client.mediaType {
 		case "application/json":
 			if err := json.NewEncoder(encodedBody).Encode(body); err != nil {
@@ -123,25 +217,66 @@ func (client *Client) NewRequest(ctx context.Context, method, path string, body
 		}
 	}
 
-	req, err := http.NewRequest(method, fullURL, encodedBody)
+	encodedBody, compressed, err := client.compressBody(encodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, encodedBody)
 	if err != nil {
 		return nil, err
 	}
+	if compressed {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
 
-	req.Header.Add("Content-Type", client.mediaType)
+	req.Header.Add("Content-Type", contentType)
 	req.Header.Add("Accept", mediaType)
 	req.Header.Add("User-Agent", client.userAgent)
+	req.Header.Set("Accept-Encoding", "gzip")
+	for key, values := range client.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	req.Header.Set("client-request-id", newUUID())
+	req.Header.Set("return-client-request-id", "true")
 
 	return req, nil
 }
 
 // Do executes the given http request and will bind the response body with v. Returns the http response as well as any error.
 func (client *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	ctx, span := client.startSpan(ctx, req)
+	stats := &requestStats{start: time.Now()}
+	if queued, ok := ctx.Value(queueWaitKey{}).(time.Duration); ok {
+		stats.waited = queued
+	}
+	response, err := client.do(ctx, req, v, stats)
+	endSpan(span, response, err, stats)
+	client.recordMetrics(ctx, req, response, err, stats)
+	return response, err
+}
+
+func (client *Client) do(ctx context.Context, req *http.Request, v interface{}, stats *requestStats) (*http.Response, error) {
+	// ctx derives from the one the request was created with, adding the tracing span and dry-run marker.
 	req = req.WithContext(ctx)
-	response, err := client.client.Do(req)
+	if response := client.dryRunResponse(ctx, req); response != nil {
+		return response, nil
+	}
+	if !client.breaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+	response, err := client.roundTrip(req, stats)
+	// Requests abandoned by the caller say nothing about graph's health.
+	if ctx.Err() == nil {
+		client.breaker.record(err != nil || response.StatusCode >= 500)
+	} else {
+		client.breaker.abandon()
+	}
+	client.logRequest(req, response, err, time.Since(stats.start))
 	if err != nil {
 		return nil, err
 	}
+	response.Body = &countingReadCloser{ReadCloser: response.Body, n: &stats.responseBytes}
 
 	defer func() {
 		if closeErr := response.Body.Close(); closeErr != nil {
@@ -162,6 +297,10 @@ func (client *Client) Do(ctx context.Context, req *http.Request, v interface{})
 			}
 		} else {
 			err = json.NewDecoder(response.Body).Decode(v)
+			// Responses to requests sent with return=minimal, and other 204s, have no body to bind.
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
 			if err != nil {
 				return response, err
 			}
@@ -171,6 +310,17 @@ func (client *Client) Do(ctx context.Context, req *http.Request, v interface{})
 	return response, err
 }
 
+// NewSessionForUser returns a new instance of a Session using this client which operates on the mailbox of the given user id or userPrincipalName.
+// This is how app-only sessions, which have no signed in user to resolve /me against, are created.
+func (client *Client) NewSessionForUser(idOrUPN string) (*Session, error) {
+	session, err := NewSession(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return session.ForUser(idOrUPN), nil
+}
+
 // NewSession returns a new instance of a Session using this client.
 func (client *Client) NewSession() (*Session, error) {
 	session, err := NewSession(client)
@@ -0,0 +1,260 @@
+package outlooktest
+
+import (
+	"time"
+
+	"github.com/ntauth/go-outlook"
+)
+
+// Addresses fixtures default to.
+const (
+	DefaultSender    = "sender@outlooktest.example"
+	DefaultRecipient = "recipient@outlooktest.example"
+)
+
+// MessageFixture builds a valid outlook.Message for tests, starting from sensible defaults: a subject, a text body,
+// a sender, a recipient, and a received time.
+type MessageFixture struct {
+	message *outlook.Message
+}
+
+// NewMessage returns a new instance of a MessageFixture with the default values set.
+func NewMessage() *MessageFixture {
+	now := time.Now().UTC().Format(outlook.DefaultQueryDateTimeFormat)
+	return &MessageFixture{
+		message: &outlook.Message{
+			Subject:     "Test message",
+			BodyPreview: "This is a test message.",
+			Body:        &outlook.MessageBody{ContentType: outlook.BodyContentTypeText, Content: "This is a test message."},
+			From:        recipient(DefaultSender),
+			Sender:      recipient(DefaultSender),
+			To:          []*outlook.Recipient{recipient(DefaultRecipient)},
+			Importance:  outlook.ImportanceNormal,
+			CreatedOn:   now,
+			ReceivedOn:  now,
+			SentOn:      now,
+		},
+	}
+}
+
+// WithID sets the message's id. Messages added to a Server are otherwise given one.
+func (mf *MessageFixture) WithID(id string) *MessageFixture {
+	mf.message.ID = id
+	return mf
+}
+
+// WithSubject sets the message's subject.
+func (mf *MessageFixture) WithSubject(subject string) *MessageFixture {
+	mf.message.Subject = subject
+	return mf
+}
+
+// WithTextBody sets the message's body to the given plain text.
+func (mf *MessageFixture) WithTextBody(text string) *MessageFixture {
+	mf.message.Body = &outlook.MessageBody{ContentType: outlook.BodyContentTypeText, Content: text}
+	mf.message.BodyPreview = preview(text)
+	return mf
+}
+
+// WithHTMLBody sets the message's body to the given html.
+func (mf *MessageFixture) WithHTMLBody(html string) *MessageFixture {
+	mf.message.Body = &outlook.MessageBody{ContentType: outlook.BodyContentTypeHTML, Content: html}
+	return mf
+}
+
+// From sets the message's sender.
+func (mf *MessageFixture) From(address string) *MessageFixture {
+	mf.message.From = recipient(address)
+	mf.message.Sender = recipient(address)
+	return mf
+}
+
+// To replaces the message's to recipients.
+func (mf *MessageFixture) To(addresses ...string) *MessageFixture {
+	mf.message.To = recipients(addresses)
+	return mf
+}
+
+// CC replaces the message's cc recipients.
+func (mf *MessageFixture) CC(addresses ...string) *MessageFixture {
+	mf.message.CC = recipients(addresses)
+	return mf
+}
+
+// WithConversation sets the message's conversation id.
+func (mf *MessageFixture) WithConversation(conversationID string) *MessageFixture {
+	mf.message.ConversationID = conversationID
+	return mf
+}
+
+// WithImportance sets the message's importance, e.g. outlook.ImportanceHigh.
+func (mf *MessageFixture) WithImportance(importance outlook.Importance) *MessageFixture {
+	mf.message.Importance = importance
+	return mf
+}
+
+// Read marks the message as read.
+func (mf *MessageFixture) Read() *MessageFixture {
+	mf.message.IsRead = true
+	return mf
+}
+
+// ReceivedAt sets when the message was sent and received.
+func (mf *MessageFixture) ReceivedAt(t time.Time) *MessageFixture {
+	formatted := t.UTC().Format(outlook.DefaultQueryDateTimeFormat)
+	mf.message.SentOn = formatted
+	mf.message.ReceivedOn = formatted
+	return mf
+}
+
+// WithAttachment adds a file attachment with the given name, content type, and content.
+func (mf *MessageFixture) WithAttachment(name, contentType string, content []byte) *MessageFixture {
+	mf.message.Attachments = append(mf.message.Attachments, &outlook.Attachment{
+		ODataType:    outlook.AttachmentODataTypeFile,
+		Name:         name,
+		ContentType:  contentType,
+		Size:         len(content),
+		ContentBytes: content,
+	})
+	mf.message.HasAttachments = true
+	return mf
+}
+
+// Build returns the message.
+func (mf *MessageFixture) Build() *outlook.Message {
+	return mf.message
+}
+
+// EventFixture builds a valid outlook.Event for tests, starting from an hour long meeting tomorrow organized by DefaultSender.
+type EventFixture struct {
+	event *outlook.Event
+}
+
+// NewEvent returns a new instance of an EventFixture with the default values set.
+func NewEvent() *EventFixture {
+	start := time.Now().UTC().Truncate(time.Hour).Add(24 * time.Hour)
+	ef := &EventFixture{
+		event: &outlook.Event{
+			Subject:     "Test event",
+			Body:        &outlook.MessageBody{ContentType: outlook.BodyContentTypeText, Content: "This is a test event."},
+			BodyPreview: "This is a test event.",
+			Organizer:   recipient(DefaultSender),
+			ShowAs:      outlook.EventShowAsBusy,
+			Sensitivity: outlook.SensitivityNormal,
+			Type:        "singleInstance",
+		},
+	}
+	return ef.At(start, time.Hour)
+}
+
+// WithID sets the event's id. Events added to a Server are otherwise given one.
+func (ef *EventFixture) WithID(id string) *EventFixture {
+	ef.event.ID = id
+	return ef
+}
+
+// WithSubject sets the event's subject.
+func (ef *EventFixture) WithSubject(subject string) *EventFixture {
+	ef.event.Subject = subject
+	return ef
+}
+
+// At sets the event's start and its duration.
+func (ef *EventFixture) At(start time.Time, duration time.Duration) *EventFixture {
+	ef.event.Start = dateTime(start)
+	ef.event.End = dateTime(start.Add(duration))
+	return ef
+}
+
+// AllDay makes the event an all day event on the given day.
+func (ef *EventFixture) AllDay(day time.Time) *EventFixture {
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	ef.event.AllDay = true
+	return ef.At(midnight, 24*time.Hour)
+}
+
+// WithLocation sets the event's location.
+func (ef *EventFixture) WithLocation(displayName string) *EventFixture {
+	ef.event.Location = &outlook.Location{DisplayName: displayName}
+	return ef
+}
+
+// OrganizedBy sets the event's organizer.
+func (ef *EventFixture) OrganizedBy(address string) *EventFixture {
+	ef.event.Organizer = recipient(address)
+	return ef
+}
+
+// WithAttendee adds an attendee of the given type, e.g. outlook.AttendeeTypeRequired.
+func (ef *EventFixture) WithAttendee(address, attendeeType string) *EventFixture {
+	ef.event.Attendees = append(ef.event.Attendees, &outlook.Attendee{
+		Type:         attendeeType,
+		EmailAddress: &outlook.EmailAddress{Address: address},
+	})
+	return ef
+}
+
+// Online makes the event a teams meeting with the given join url.
+func (ef *EventFixture) Online(joinURL string) *EventFixture {
+	ef.event.IsOnlineMeeting = true
+	ef.event.OnlineMeetingProvider = outlook.OnlineMeetingProviderTeamsForBusiness
+	ef.event.OnlineMeeting = &outlook.OnlineMeetingInfo{JoinURL: joinURL}
+	return ef
+}
+
+// Build returns the event.
+func (ef *EventFixture) Build() *outlook.Event {
+	return ef.event
+}
+
+// FolderFixture builds a valid outlook.Folder for tests.
+type FolderFixture struct {
+	folder *outlook.Folder
+}
+
+// NewFolder returns a new instance of a FolderFixture with the given display name.
+func NewFolder(displayName string) *FolderFixture {
+	return &FolderFixture{folder: &outlook.Folder{DisplayName: displayName}}
+}
+
+// WithID sets the folder's id. Folders added to a Server are otherwise given one.
+func (ff *FolderFixture) WithID(id string) *FolderFixture {
+	ff.folder.ID = id
+	return ff
+}
+
+// WithParent sets the folder's parent folder.
+func (ff *FolderFixture) WithParent(parentFolderID string) *FolderFixture {
+	ff.folder.ParentFolderID = parentFolderID
+	return ff
+}
+
+// Build returns the folder.
+func (ff *FolderFixture) Build() *outlook.Folder {
+	return ff.folder
+}
+
+func recipient(address string) *outlook.Recipient {
+	return &outlook.Recipient{EmailAddress: &outlook.EmailAddress{Address: address}}
+}
+
+func recipients(addresses []string) []*outlook.Recipient {
+	result := make([]*outlook.Recipient, len(addresses))
+	for i, address := range addresses {
+		result[i] = recipient(address)
+	}
+	return result
+}
+
+func dateTime(t time.Time) *outlook.DateTimeTimeZone {
+	return outlook.NewDateTimeTimeZone(t.UTC())
+}
+
+// preview returns the first 255 characters of text, like graph's bodyPreview.
+func preview(text string) string {
+	runes := []rune(text)
+	if len(runes) > 255 {
+		runes = runes[:255]
+	}
+	return string(runes)
+}
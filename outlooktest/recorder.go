@@ -0,0 +1,242 @@
+package outlooktest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RecorderMode whether a Recorder sends requests to graph or replays them from its fixture.
+type RecorderMode int
+
+const (
+	// ModeReplay serves every request from the fixture, failing requests which were not recorded
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends every request to graph and records it, overwriting the fixture on Stop
+	ModeRecord
+	// ModeRecordOnce replays the fixture when it exists and records one otherwise
+	ModeRecordOnce
+)
+
+// ErrNoInteraction is returned by a replaying Recorder for a request missing from its fixture.
+var ErrNoInteraction = errors.New("outlooktest: no recorded interaction matches the request")
+
+// Headers never written to fixtures, either because they carry credentials or because they differ between runs.
+var recorderDroppedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Client-Request-Id", "Request-Id", "Date", "X-Ms-Ags-Diagnostic"}
+
+var recorderSecretPattern = regexp.MustCompile(`("(?i:access_token|refresh_token|id_token|client_secret|password)"\s*:\s*)"[^"]*"`)
+
+// Recorder an http.RoundTripper which records request and response pairs to a fixture file and replays them deterministically,
+// so tests can exercise real graph behavior in CI without live credentials. Credentials are stripped from recorded requests
+// and responses, but message contents are kept as is, so fixtures should be recorded against test mailboxes.
+//
+// Requests are matched on method, path, query, and body; identical requests are replayed in the order they were recorded.
+type Recorder struct {
+	path string
+	mode RecorderMode
+	base http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// Interaction a recorded request and the response graph gave it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest the parts of a request recorded in a fixture.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// URL the request's path and query, without scheme and host, so fixtures replay against any base url.
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse the parts of a response recorded in a fixture.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewRecorder returns a new instance of a Recorder using the fixture at path. base sends requests when recording
+// and defaults to http.DefaultTransport. Pass it to outlook.SetClientHTTPClient wrapped in an http.Client.
+func NewRecorder(path string, mode RecorderMode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	r := &Recorder{path: path, mode: mode, base: base}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if mode == ModeReplay {
+			return nil, fmt.Errorf("outlooktest: fixture %s does not exist", path)
+		}
+		if mode == ModeRecordOnce {
+			r.mode = ModeRecord
+		}
+		return r, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if mode == ModeRecord {
+		return r, nil
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, fmt.Errorf("outlooktest: failed to parse fixture %s: %w", path, err)
+	}
+	r.mode = ModeReplay
+	r.used = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Recording reports whether the recorder is sending requests to graph rather than replaying them.
+func (r *Recorder) Recording() bool {
+	return r.mode == ModeRecord
+}
+
+// RoundTrip records or replays req.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Header: sanitizeHeader(req.Header),
+		Body:   sanitizeBody(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	res, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := readResponseBody(res)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     sanitizeHeader(res.Header),
+			Body:       sanitizeBody(resBody),
+		},
+	})
+	r.mu.Unlock()
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request.Method != recorded.Method || interaction.Request.URL != recorded.URL || interaction.Request.Body != recorded.Body {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.URL)
+}
+
+// Stop writes the recorded interactions to the fixture when recording. It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data, 0o644)
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(zr)
+	}
+	return body, nil
+}
+
+// readResponseBody reads res's body, decompressing it so fixtures stay readable and diffable.
+func readResponseBody(res *http.Response) ([]byte, error) {
+	defer res.Body.Close()
+
+	var reader io.Reader = res.Body
+	if strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		zr, err := gzip.NewReader(res.Body)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if zr != nil {
+			reader = zr
+		}
+		res.Header.Del("Content-Encoding")
+		res.Header.Del("Content-Length")
+		res.ContentLength = -1
+	}
+	return io.ReadAll(reader)
+}
+
+func sanitizeHeader(header http.Header) http.Header {
+	sanitized := header.Clone()
+	for _, key := range recorderDroppedHeaders {
+		sanitized.Del(key)
+	}
+	return sanitized
+}
+
+func sanitizeBody(body []byte) string {
+	return string(recorderSecretPattern.ReplaceAll(body, []byte(`${1}"[REDACTED]"`)))
+}
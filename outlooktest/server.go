@@ -0,0 +1,1011 @@
+// Package outlooktest provides an in-memory fake of microsoft's graph api, serving the mail and calendar endpoints used by
+// the outlook package, so code built on it can be tested realistically without a tenant.
+package outlooktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ntauth/go-outlook"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultPageSize the page size used when a list request does not set $top
+	DefaultPageSize = 10
+	// Me the mailbox key of the signed in user, whose mailbox is served under /me
+	Me = "me"
+	// AccessToken the token sessions created by the server authenticate with
+	AccessToken = "outlooktest-access-token"
+
+	dateTimeFormat = "2006-01-02T15:04:05Z"
+)
+
+// Well known folders every mailbox is created with, addressable by name like in graph.
+var wellKnownFolders = []string{outlook.FolderInbox, outlook.FolderDrafts, outlook.FolderSentItems, outlook.FolderDeletedItems, outlook.FolderArchive, outlook.FolderJunkEmail}
+
+// Server an in-memory fake graph api. Mailboxes are created on first use, keyed by Me, the user id or address used in /users/{id}
+// paths, or GroupKey of the group id used in /groups/{id} paths.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	mailboxes map[string]*Mailbox
+	nextID    int
+}
+
+// NewServer returns a new, started instance of a Server. Callers should Close it when done.
+func NewServer() *Server {
+	s := &Server{mailboxes: map[string]*Mailbox{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns a new instance of an outlook.Client which sends its requests to the server, authenticated with AccessToken.
+// opts are applied after the server's own, so they can override them.
+func (s *Server) NewClient(opts ...outlook.ClientOpt) (*outlook.Client, error) {
+	opts = append([]outlook.ClientOpt{
+		outlook.SetClientBaseURL(s.URL + "/v1.0"),
+		outlook.SetClientHTTPClient(s.Client()),
+		outlook.SetClientTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: AccessToken})),
+	}, opts...)
+	return outlook.NewClient(opts...)
+}
+
+// NewSession returns a new instance of an outlook.Session for the Me mailbox on the server.
+func (s *Server) NewSession(opts ...outlook.ClientOpt) (*outlook.Session, error) {
+	client, err := s.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewSession()
+}
+
+// GroupKey returns the mailbox key of the microsoft 365 group with the given id, whose mailbox is served under /groups/{id}.
+func GroupKey(groupID string) string {
+	return "groups/" + groupID
+}
+
+// Mailbox returns the mailbox stored under key, creating it if needed. Use Me for the signed in user's mailbox.
+func (s *Server) Mailbox(key string) *Mailbox {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mailbox(key)
+}
+
+func (s *Server) mailbox(key string) *Mailbox {
+	key = strings.ToLower(key)
+	mb, ok := s.mailboxes[key]
+	if !ok {
+		mb = newMailbox(s)
+		s.mailboxes[key] = mb
+	}
+	return mb
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%040d", prefix, s.nextID)
+}
+
+// Mailbox the folders, messages, and events of a single user on a Server. Its methods seed and inspect state directly,
+// bypassing the http api, and are safe to call while requests are being served.
+type Mailbox struct {
+	server   *Server
+	version  int
+	folders  map[string]*outlook.Folder
+	messages map[string]*storedMessage
+	events   map[string]*outlook.Event
+	// tombstones the version each deleted message was removed at, keyed by id, for delta queries.
+	tombstones map[string]tombstone
+	// eventVersions and eventTombstones the version each event was last changed and removed at, keyed by id, for delta queries.
+	eventVersions   map[string]int
+	eventTombstones map[string]int
+}
+
+type storedMessage struct {
+	folderID string
+	version  int
+	message  *outlook.Message
+}
+
+type tombstone struct {
+	folderID string
+	version  int
+}
+
+func newMailbox(s *Server) *Mailbox {
+	mb := &Mailbox{
+		server:     s,
+		folders:    map[string]*outlook.Folder{},
+		messages:   map[string]*storedMessage{},
+		events:     map[string]*outlook.Event{},
+		tombstones: map[string]tombstone{},
+
+		eventVersions:   map[string]int{},
+		eventTombstones: map[string]int{},
+	}
+	for _, name := range wellKnownFolders {
+		mb.folders[name] = &outlook.Folder{ID: name, DisplayName: name}
+	}
+	return mb
+}
+
+// AddFolder stores folder in the mailbox, assigning it an id if it has none, and returns it.
+func (mb *Mailbox) AddFolder(folder *outlook.Folder) *outlook.Folder {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	return mb.addFolder(folder)
+}
+
+func (mb *Mailbox) addFolder(folder *outlook.Folder) *outlook.Folder {
+	if folder.ID == "" {
+		folder.ID = mb.server.newID("AAMkFolder")
+	}
+	mb.folders[folder.ID] = folder
+	return folder
+}
+
+// removeFolder removes the folder along with its messages and child folders.
+func (mb *Mailbox) removeFolder(id string) {
+	for childID, child := range mb.folders {
+		if child.ParentFolderID == id {
+			mb.removeFolder(childID)
+		}
+	}
+	for messageID, sm := range mb.messages {
+		if sm.folderID == id {
+			mb.deleteMessage(messageID)
+		}
+	}
+	delete(mb.folders, id)
+}
+
+// AddMessage stores message in the given folder, which may be a well known name such as "inbox", and returns it.
+func (mb *Mailbox) AddMessage(folderID string, message *outlook.Message) *outlook.Message {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	return mb.addMessage(mb.resolveFolder(folderID), message)
+}
+
+func (mb *Mailbox) addMessage(folderID string, message *outlook.Message) *outlook.Message {
+	if message.ID == "" {
+		message.ID = mb.server.newID("AAMkMessage")
+	}
+	now := time.Now().UTC().Format(dateTimeFormat)
+	if message.CreatedOn == "" {
+		message.CreatedOn = now
+	}
+	if message.ReceivedOn == "" {
+		message.ReceivedOn = now
+	}
+	mb.touch(folderID, message)
+	return message
+}
+
+// touch records a change to message, so delta queries report it.
+func (mb *Mailbox) touch(folderID string, message *outlook.Message) {
+	mb.version++
+	message.ETag = fmt.Sprintf("W/\"%d\"", mb.version)
+	mb.messages[message.ID] = &storedMessage{folderID: folderID, version: mb.version, message: message}
+	delete(mb.tombstones, message.ID)
+}
+
+func (mb *Mailbox) deleteMessage(id string) {
+	stored := mb.messages[id]
+	delete(mb.messages, id)
+	mb.version++
+	mb.tombstones[id] = tombstone{folderID: stored.folderID, version: mb.version}
+}
+
+// DeleteMessage removes the message with the given id, as if it were deleted by another client.
+func (mb *Mailbox) DeleteMessage(id string) {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	if _, ok := mb.messages[id]; ok {
+		mb.deleteMessage(id)
+	}
+}
+
+// Messages returns the messages in the given folder, oldest first.
+func (mb *Mailbox) Messages(folderID string) []*outlook.Message {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	return mb.folderMessages(mb.resolveFolder(folderID))
+}
+
+func (mb *Mailbox) folderMessages(folderID string) []*outlook.Message {
+	var stored []*storedMessage
+	for _, sm := range mb.messages {
+		if folderID == "" || sm.folderID == folderID {
+			stored = append(stored, sm)
+		}
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		return stored[i].version < stored[j].version
+	})
+	messages := make([]*outlook.Message, len(stored))
+	for i, sm := range stored {
+		messages[i] = sm.message
+	}
+	return messages
+}
+
+// AddEvent stores event in the mailbox's primary calendar, assigning it an id if it has none, and returns it.
+func (mb *Mailbox) AddEvent(event *outlook.Event) *outlook.Event {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	return mb.addEvent(event)
+}
+
+func (mb *Mailbox) addEvent(event *outlook.Event) *outlook.Event {
+	if event.ID == "" {
+		event.ID = mb.server.newID("AAMkEvent")
+	}
+	if event.CreatedOn == "" {
+		event.CreatedOn = time.Now().UTC().Format(dateTimeFormat)
+	}
+	mb.version++
+	event.ETag = fmt.Sprintf("W/\"%d\"", mb.version)
+	mb.events[event.ID] = event
+	mb.eventVersions[event.ID] = mb.version
+	delete(mb.eventTombstones, event.ID)
+	return event
+}
+
+func (mb *Mailbox) deleteEvent(id string) {
+	mb.version++
+	delete(mb.events, id)
+	delete(mb.eventVersions, id)
+	mb.eventTombstones[id] = mb.version
+}
+
+// DeleteEvent removes the event with the given id from the mailbox's calendar, as if its owner deleted it.
+func (mb *Mailbox) DeleteEvent(id string) {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	mb.deleteEvent(id)
+}
+
+// Events returns the events in the mailbox's calendar, ordered by start.
+func (mb *Mailbox) Events() []*outlook.Event {
+	mb.server.mu.Lock()
+	defer mb.server.mu.Unlock()
+	return mb.sortedEvents()
+}
+
+func (mb *Mailbox) sortedEvents() []*outlook.Event {
+	events := make([]*outlook.Event, 0, len(mb.events))
+	for _, event := range mb.events {
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return eventStart(events[i]).Before(eventStart(events[j]))
+	})
+	return events
+}
+
+// resolveFolder maps well known folder names, in any case, to their id.
+func (mb *Mailbox) resolveFolder(folderID string) string {
+	if _, ok := mb.folders[strings.ToLower(folderID)]; ok {
+		return strings.ToLower(folderID)
+	}
+	return folderID
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+AccessToken {
+		writeError(w, http.StatusUnauthorized, "InvalidAuthenticationToken", "Access token is empty or invalid.")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) > 0 && (segments[0] == outlook.APIVersionV1 || segments[0] == outlook.APIVersionBeta) {
+		segments = segments[1:]
+	}
+
+	var key string
+	switch {
+	case len(segments) >= 1 && segments[0] == "me":
+		key, segments = Me, segments[1:]
+	case len(segments) >= 2 && segments[0] == "users":
+		key, segments = segments[1], segments[2:]
+	case len(segments) >= 2 && segments[0] == "groups":
+		key, segments = GroupKey(segments[1]), segments[2:]
+	default:
+		writeError(w, http.StatusBadRequest, "BadRequest", fmt.Sprintf("Resource not found for the segment '%s'.", r.URL.Path))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	(&request{server: s, mailbox: s.mailbox(key), w: w, r: r}).route(segments)
+}
+
+// request the state of a single request being served. The server's lock is held for its whole lifetime.
+type request struct {
+	server  *Server
+	mailbox *Mailbox
+	w       http.ResponseWriter
+	r       *http.Request
+}
+
+func (req *request) route(segments []string) {
+	method := req.r.Method
+	switch {
+	case match(segments, "mailFolders") && method == http.MethodGet:
+		req.listFolders()
+	case match(segments, "mailFolders") && method == http.MethodPost:
+		req.createFolder("")
+	case match(segments, "mailFolders", "*"):
+		req.folder(segments[1])
+	case match(segments, "mailFolders", "*", "childFolders") && method == http.MethodGet:
+		req.listChildFolders(segments[1])
+	case match(segments, "mailFolders", "*", "childFolders") && method == http.MethodPost:
+		req.createFolder(segments[1])
+	case match(segments, "mailFolders", "*", "permanentDelete") && method == http.MethodPost:
+		req.permanentDeleteFolder(segments[1])
+	case (match(segments, "mailFolders", "*", "move") || match(segments, "mailFolders", "*", "copy")) && method == http.MethodPost:
+		req.moveFolder(segments[1], segments[2] == "copy")
+	case match(segments, "mailFolders", "*", "messages") && method == http.MethodGet:
+		req.listMessages(segments[1])
+	case match(segments, "mailFolders", "*", "messages") && method == http.MethodPost:
+		req.createMessage(segments[1])
+	case match(segments, "mailFolders", "*", "messages", "delta") && method == http.MethodGet:
+		req.deltaMessages(segments[1])
+	case match(segments, "messages") && method == http.MethodGet:
+		req.listMessages("")
+	case match(segments, "messages") && method == http.MethodPost:
+		req.createMessage("drafts")
+	case match(segments, "messages", "*"):
+		req.message(segments[1])
+	case match(segments, "messages", "*", "$value") && method == http.MethodGet:
+		req.messageMIME(segments[1])
+	case match(segments, "messages", "*", "permanentDelete") && method == http.MethodPost:
+		req.permanentDeleteMessage(segments[1])
+	case (match(segments, "messages", "*", "move") || match(segments, "messages", "*", "copy")) && method == http.MethodPost:
+		req.moveMessage(segments[1], segments[2] == "copy")
+	case match(segments, "messages", "*", "send") && method == http.MethodPost:
+		req.sendDraft(segments[1])
+	case match(segments, "sendMail") && method == http.MethodPost:
+		req.sendMail()
+	case match(segments, "calendar") && method == http.MethodGet:
+		req.defaultCalendar()
+	case match(segments, "events") && method == http.MethodGet:
+		req.listEvents()
+	case (match(segments, "events") || match(segments, "calendars", "*", "events")) && method == http.MethodPost:
+		req.createEvent()
+	case match(segments, "events", "*"):
+		req.event(segments[1])
+	case match(segments, "calendars", "*", "events", "*"):
+		req.event(segments[3])
+	case (match(segments, "calendarView") || match(segments, "calendars", "*", "calendarView")) && method == http.MethodGet:
+		req.calendarView()
+	case (match(segments, "calendarView", "delta") || match(segments, "calendars", "*", "calendarView", "delta")) && method == http.MethodGet:
+		req.deltaCalendarView()
+	default:
+		writeError(req.w, http.StatusBadRequest, "BadRequest", fmt.Sprintf("Resource not found for the segment '%s'.", strings.Join(segments, "/")))
+	}
+}
+
+// match reports whether segments matches pattern, where "*" matches any single segment.
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (req *request) listFolders() {
+	folders := make([]*outlook.Folder, 0, len(req.mailbox.folders))
+	for _, folder := range req.mailbox.folders {
+		if folder.ParentFolderID == "" && req.visible(folder) {
+			folders = append(folders, req.withCounts(folder))
+		}
+	}
+	sort.Slice(folders, func(i, j int) bool {
+		return folders[i].DisplayName < folders[j].DisplayName
+	})
+	writePage(req, folders)
+}
+
+// visible reports whether folder is listed, hidden folders only being listed when includeHiddenFolders is set.
+func (req *request) visible(folder *outlook.Folder) bool {
+	return !folder.IsHidden || req.r.URL.Query().Get("includeHiddenFolders") == "true"
+}
+
+func (req *request) withCounts(folder *outlook.Folder) *outlook.Folder {
+	counted := *folder
+	counted.TotalItemCount, counted.UnreadItemCount, counted.ChildFolderCount = 0, 0, 0
+	for _, sm := range req.mailbox.messages {
+		if sm.folderID == folder.ID {
+			counted.TotalItemCount++
+			if !sm.message.IsRead {
+				counted.UnreadItemCount++
+			}
+		}
+	}
+	for _, child := range req.mailbox.folders {
+		if child.ParentFolderID == folder.ID {
+			counted.ChildFolderCount++
+		}
+	}
+	return &counted
+}
+
+func (req *request) folder(id string) {
+	folder, ok := req.mailbox.folders[req.mailbox.resolveFolder(id)]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+
+	switch req.r.Method {
+	case http.MethodGet:
+		writeJSON(req.w, http.StatusOK, req.withCounts(folder))
+	case http.MethodPatch:
+		var patch outlook.Folder
+		if !req.decode(&patch) {
+			return
+		}
+		if patch.DisplayName != "" {
+			folder.DisplayName = patch.DisplayName
+		}
+		writeJSON(req.w, http.StatusOK, req.withCounts(folder))
+	case http.MethodDelete:
+		// Graph moves deleted folders, contents and all, to Deleted Items.
+		folder.ParentFolderID = outlook.FolderDeletedItems
+		req.w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(req.w, http.StatusMethodNotAllowed, "BadRequest", "The http method is not supported for this resource.")
+	}
+}
+
+// permanentDeleteFolder removes the folder along with its messages and child folders.
+func (req *request) permanentDeleteFolder(id string) {
+	id = req.mailbox.resolveFolder(id)
+	if _, ok := req.mailbox.folders[id]; !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	req.mailbox.removeFolder(id)
+	req.w.WriteHeader(http.StatusNoContent)
+}
+
+func (req *request) listChildFolders(parentID string) {
+	parentID = req.mailbox.resolveFolder(parentID)
+	if _, ok := req.mailbox.folders[parentID]; !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	folders := make([]*outlook.Folder, 0)
+	for _, folder := range req.mailbox.folders {
+		if folder.ParentFolderID == parentID && req.visible(folder) {
+			folders = append(folders, req.withCounts(folder))
+		}
+	}
+	sort.Slice(folders, func(i, j int) bool {
+		return folders[i].DisplayName < folders[j].DisplayName
+	})
+	writePage(req, folders)
+}
+
+// moveFolder moves the folder, or copies it without its contents, into the request's destinationId.
+func (req *request) moveFolder(id string, duplicate bool) {
+	folder, ok := req.mailbox.folders[req.mailbox.resolveFolder(id)]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	var body struct {
+		DestinationID string `json:"destinationId"`
+	}
+	if !req.decode(&body) {
+		return
+	}
+	destinationID := req.mailbox.resolveFolder(body.DestinationID)
+	if _, ok := req.mailbox.folders[destinationID]; !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+
+	if duplicate {
+		copied := *folder
+		copied.ID = ""
+		folder = req.mailbox.addFolder(&copied)
+	}
+	folder.ParentFolderID = destinationID
+	writeJSON(req.w, http.StatusCreated, req.withCounts(folder))
+}
+
+func (req *request) createFolder(parentID string) {
+	var folder outlook.Folder
+	if !req.decode(&folder) {
+		return
+	}
+	folder.ID = ""
+	folder.ParentFolderID = req.mailbox.resolveFolder(parentID)
+	writeJSON(req.w, http.StatusCreated, req.mailbox.addFolder(&folder))
+}
+
+func (req *request) listMessages(folderID string) {
+	if folderID != "" {
+		folderID = req.mailbox.resolveFolder(folderID)
+		if _, ok := req.mailbox.folders[folderID]; !ok {
+			writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+			return
+		}
+	}
+	messages := req.mailbox.folderMessages(folderID)
+	if filter := req.r.URL.Query().Get("$filter"); filter != "" {
+		conversationID, ok := parseConversationFilter(filter)
+		if !ok {
+			writeError(req.w, http.StatusBadRequest, "ErrorInvalidUrlQueryFilter", "The query filter contains one or more invalid nodes.")
+			return
+		}
+		filtered := messages[:0]
+		for _, message := range messages {
+			if message.ConversationID == conversationID {
+				filtered = append(filtered, message)
+			}
+		}
+		messages = filtered
+	}
+	// Graph lists messages newest first.
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	writePage(req, messages)
+}
+
+// parseConversationFilter parses the only $filter the server supports on messages, conversationId eq '<id>'.
+func parseConversationFilter(filter string) (string, bool) {
+	value, ok := strings.CutPrefix(filter, "conversationId eq '")
+	if !ok || !strings.HasSuffix(value, "'") {
+		return "", false
+	}
+	return strings.ReplaceAll(strings.TrimSuffix(value, "'"), "''", "'"), true
+}
+
+func (req *request) createMessage(folderID string) {
+	var message outlook.Message
+	if !req.decode(&message) {
+		return
+	}
+	message.ID = ""
+	writeJSON(req.w, http.StatusCreated, req.mailbox.addMessage(req.mailbox.resolveFolder(folderID), &message))
+}
+
+func (req *request) message(id string) {
+	stored, ok := req.mailbox.messages[id]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	if !req.checkETag(stored.message.ETag) {
+		return
+	}
+
+	switch req.r.Method {
+	case http.MethodGet:
+		writeJSON(req.w, http.StatusOK, stored.message)
+	case http.MethodPatch:
+		updated := *stored.message
+		if !req.decode(&updated) {
+			return
+		}
+		updated.ID = id
+		req.mailbox.touch(stored.folderID, &updated)
+		writeJSON(req.w, http.StatusOK, &updated)
+	case http.MethodDelete:
+		req.mailbox.deleteMessage(id)
+		req.w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(req.w, http.StatusMethodNotAllowed, "BadRequest", "Unsupported method.")
+	}
+}
+
+// messageMIME serves the message's $value, its RFC 5322 MIME content as written by outlook.WriteMIME.
+func (req *request) messageMIME(id string) {
+	stored, ok := req.mailbox.messages[id]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	var buf bytes.Buffer
+	if err := outlook.WriteMIME(&buf, stored.message, ""); err != nil {
+		writeError(req.w, http.StatusInternalServerError, "ErrorInternalServerError", err.Error())
+		return
+	}
+	req.w.Header().Set("Content-Type", "text/plain")
+	req.w.WriteHeader(http.StatusOK)
+	req.w.Write(buf.Bytes())
+}
+
+// moveMessage moves or copies the message into the request's destinationId. Like in graph, the moved message gets a new id.
+func (req *request) moveMessage(id string, duplicate bool) {
+	stored, ok := req.mailbox.messages[id]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	var body struct {
+		DestinationID string `json:"destinationId"`
+	}
+	if !req.decode(&body) {
+		return
+	}
+	destinationID := req.mailbox.resolveFolder(body.DestinationID)
+	if _, ok := req.mailbox.folders[destinationID]; !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+
+	moved := *stored.message
+	moved.ID = ""
+	if !duplicate {
+		req.mailbox.deleteMessage(id)
+	}
+	writeJSON(req.w, http.StatusCreated, req.mailbox.addMessage(destinationID, &moved))
+}
+
+func (req *request) permanentDeleteMessage(id string) {
+	if _, ok := req.mailbox.messages[id]; !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	req.mailbox.deleteMessage(id)
+	req.w.WriteHeader(http.StatusNoContent)
+}
+
+func (req *request) sendMail() {
+	var body struct {
+		Message         *outlook.Message `json:"message"`
+		SaveToSentItems *bool            `json:"saveToSentItems"`
+	}
+	if !req.decode(&body) {
+		return
+	}
+	if body.Message == nil {
+		writeError(req.w, http.StatusBadRequest, "ErrorInvalidRecipients", "At least one recipient is not valid.")
+		return
+	}
+
+	req.deliver(body.Message, body.SaveToSentItems == nil || *body.SaveToSentItems)
+	req.w.WriteHeader(http.StatusAccepted)
+}
+
+// sendDraft sends the draft with the given id, moving it to Sent Items. A deferred send time is ignored: the draft is
+// delivered right away.
+func (req *request) sendDraft(id string) {
+	stored, ok := req.mailbox.messages[id]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	req.mailbox.deleteMessage(id)
+	req.deliver(stored.message, true)
+	req.w.WriteHeader(http.StatusAccepted)
+}
+
+// deliver saves a copy of message to Sent Items, if save is set, and delivers it to the recipients with a mailbox on the server.
+func (req *request) deliver(message *outlook.Message, save bool) {
+	now := time.Now().UTC().Format(dateTimeFormat)
+	if save {
+		sent := *message
+		sent.ID, sent.SentOn, sent.IsRead = "", now, true
+		req.mailbox.addMessage("sentitems", &sent)
+	}
+
+	// Recipients with a mailbox on the server receive a copy in their inbox.
+	var recipients []*outlook.Recipient
+	recipients = append(recipients, message.To...)
+	recipients = append(recipients, message.CC...)
+	recipients = append(recipients, message.BCC...)
+	for _, recipient := range recipients {
+		if recipient == nil || recipient.EmailAddress == nil {
+			continue
+		}
+		mailbox, ok := req.server.mailboxes[strings.ToLower(recipient.EmailAddress.Address)]
+		if !ok {
+			continue
+		}
+		received := *message
+		received.ID, received.SentOn, received.ReceivedOn, received.IsRead = "", now, now, false
+		mailbox.addMessage("inbox", &received)
+	}
+}
+
+func (req *request) deltaMessages(folderID string) {
+	folderID = req.mailbox.resolveFolder(folderID)
+	since := 0
+	if token := req.r.URL.Query().Get("$deltatoken"); token != "" {
+		var err error
+		if since, err = strconv.Atoi(token); err != nil {
+			writeError(req.w, http.StatusBadRequest, "SyncStateInvalid", "The sync state is invalid.")
+			return
+		}
+	}
+
+	type change struct {
+		version int
+		item    interface{}
+	}
+	var changes []change
+	for _, sm := range req.mailbox.messages {
+		if sm.folderID == folderID && sm.version > since {
+			changes = append(changes, change{sm.version, sm.message})
+		}
+	}
+	if since > 0 {
+		for id, ts := range req.mailbox.tombstones {
+			if ts.folderID == folderID && ts.version > since {
+				removed := map[string]interface{}{"id": id, "@removed": map[string]string{"reason": "deleted"}}
+				changes = append(changes, change{ts.version, removed})
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].version < changes[j].version
+	})
+
+	items := make([]interface{}, len(changes))
+	for i, c := range changes {
+		items[i] = c.item
+	}
+	top, skip := req.paging()
+	page := paginate(items, top, skip)
+	response := map[string]interface{}{"value": page}
+	if skip+top < len(items) {
+		response["@odata.nextLink"] = req.link(map[string]string{
+			"$deltatoken": strconv.Itoa(since),
+			"$skip":       strconv.Itoa(skip + top),
+			"$top":        strconv.Itoa(top),
+		})
+	} else {
+		response["@odata.deltaLink"] = req.link(map[string]string{"$deltatoken": strconv.Itoa(req.mailbox.version)})
+	}
+	writeJSON(req.w, http.StatusOK, response)
+}
+
+func (req *request) defaultCalendar() {
+	writeJSON(req.w, http.StatusOK, &outlook.Calendar{
+		ID:                "calendar",
+		Name:              "Calendar",
+		CanEdit:           true,
+		IsDefaultCalendar: true,
+	})
+}
+
+func (req *request) listEvents() {
+	writePage(req, req.mailbox.sortedEvents())
+}
+
+func (req *request) createEvent() {
+	var event outlook.Event
+	if !req.decode(&event) {
+		return
+	}
+	event.ID = ""
+	writeJSON(req.w, http.StatusCreated, req.mailbox.addEvent(&event))
+}
+
+func (req *request) event(id string) {
+	event, ok := req.mailbox.events[id]
+	if !ok {
+		writeError(req.w, http.StatusNotFound, "ErrorItemNotFound", "The specified object was not found in the store.")
+		return
+	}
+	if !req.checkETag(event.ETag) {
+		return
+	}
+
+	switch req.r.Method {
+	case http.MethodGet:
+		writeJSON(req.w, http.StatusOK, event)
+	case http.MethodPatch:
+		updated := *event
+		if !req.decode(&updated) {
+			return
+		}
+		updated.ID = id
+		writeJSON(req.w, http.StatusOK, req.mailbox.addEvent(&updated))
+	case http.MethodDelete:
+		req.mailbox.deleteEvent(id)
+		req.w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(req.w, http.StatusMethodNotAllowed, "BadRequest", "Unsupported method.")
+	}
+}
+
+func (req *request) calendarView() {
+	query := req.r.URL.Query()
+	start, errStart := time.Parse(time.RFC3339, query.Get("startDateTime"))
+	end, errEnd := time.Parse(time.RFC3339, query.Get("endDateTime"))
+	if errStart != nil || errEnd != nil {
+		writeError(req.w, http.StatusBadRequest, "ErrorInvalidParameter", "This request requires a time window specified by the query string parameters StartDateTime and EndDateTime.")
+		return
+	}
+
+	var events []*outlook.Event
+	for _, event := range req.mailbox.sortedEvents() {
+		if eventStart(event).Before(end) && eventEnd(event).After(start) {
+			events = append(events, event)
+		}
+	}
+	writePage(req, events)
+}
+
+// deltaCalendarView serves calendarView delta rounds. Unlike graph, which keeps the window in an opaque token, the window
+// is carried through next and delta links as plain query parameters.
+func (req *request) deltaCalendarView() {
+	query := req.r.URL.Query()
+	start, errStart := time.Parse(time.RFC3339, query.Get("startDateTime"))
+	end, errEnd := time.Parse(time.RFC3339, query.Get("endDateTime"))
+	if errStart != nil || errEnd != nil {
+		writeError(req.w, http.StatusBadRequest, "ErrorInvalidParameter", "This request requires a time window specified by the query string parameters StartDateTime and EndDateTime.")
+		return
+	}
+	since := 0
+	if token := query.Get("$deltatoken"); token != "" {
+		var err error
+		if since, err = strconv.Atoi(token); err != nil {
+			writeError(req.w, http.StatusGone, "SyncStateNotFound", "The sync state is invalid or has expired.")
+			return
+		}
+	}
+
+	type change struct {
+		version int
+		item    interface{}
+	}
+	var changes []change
+	for id, event := range req.mailbox.events {
+		if version := req.mailbox.eventVersions[id]; version > since && eventStart(event).Before(end) && eventEnd(event).After(start) {
+			changes = append(changes, change{version, event})
+		}
+	}
+	if since > 0 {
+		for id, version := range req.mailbox.eventTombstones {
+			if version > since {
+				removed := map[string]interface{}{"id": id, "@removed": map[string]string{"reason": "deleted"}}
+				changes = append(changes, change{version, removed})
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].version < changes[j].version
+	})
+
+	items := make([]interface{}, len(changes))
+	for i, c := range changes {
+		items[i] = c.item
+	}
+	top := DefaultPageSize
+	if _, err := fmt.Sscanf(req.r.Header.Get("Prefer"), "odata.maxpagesize=%d", &top); err != nil || top <= 0 {
+		top = DefaultPageSize
+	}
+	skip, _ := strconv.Atoi(query.Get("$skip"))
+	window := map[string]string{"startDateTime": query.Get("startDateTime"), "endDateTime": query.Get("endDateTime")}
+	response := map[string]interface{}{"value": paginate(items, top, skip)}
+	if skip+top < len(items) {
+		window["$deltatoken"] = strconv.Itoa(since)
+		window["$skip"] = strconv.Itoa(skip + top)
+		response["@odata.nextLink"] = req.link(window)
+	} else {
+		window["$deltatoken"] = strconv.Itoa(req.mailbox.version)
+		response["@odata.deltaLink"] = req.link(window)
+	}
+	writeJSON(req.w, http.StatusOK, response)
+}
+
+// checkETag fails the request with a 412 when it carries an If-Match header which does not match etag.
+func (req *request) checkETag(etag string) bool {
+	ifMatch := req.r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" || ifMatch == etag {
+		return true
+	}
+	writeError(req.w, http.StatusPreconditionFailed, "ErrorIrresolvableConflict", "The send or update operation could not be performed because the change key passed in the request does not match the current change key for the item.")
+	return false
+}
+
+func (req *request) decode(v interface{}) bool {
+	if err := json.NewDecoder(req.r.Body).Decode(v); err != nil {
+		writeError(req.w, http.StatusBadRequest, "RequestBodyRead", err.Error())
+		return false
+	}
+	return true
+}
+
+// paging returns the request's $top and $skip.
+func (req *request) paging() (int, int) {
+	query := req.r.URL.Query()
+	top, err := strconv.Atoi(query.Get("$top"))
+	if err != nil || top <= 0 {
+		top = DefaultPageSize
+	}
+	skip, _ := strconv.Atoi(query.Get("$skip"))
+	return top, skip
+}
+
+// link returns the absolute url of the request's path with the given query.
+func (req *request) link(params map[string]string) string {
+	query := url.Values{}
+	for key, value := range params {
+		query.Set(key, value)
+	}
+	return fmt.Sprintf("%s%s?%s", req.server.URL, req.r.URL.Path, query.Encode())
+}
+
+// writePage writes the page of items selected by the request's $top and $skip, with a nextLink carrying the following $skip.
+func writePage[T any](req *request, items []T) {
+	top, skip := req.paging()
+	response := map[string]interface{}{"value": paginate(items, top, skip)}
+	if req.r.URL.Query().Get("$count") == "true" {
+		response["@odata.count"] = len(items)
+	}
+	if skip+top < len(items) {
+		query := req.r.URL.Query()
+		query.Set("$skip", strconv.Itoa(skip+top))
+		response["@odata.nextLink"] = fmt.Sprintf("%s%s?%s", req.server.URL, req.r.URL.Path, query.Encode())
+	}
+	writeJSON(req.w, http.StatusOK, response)
+}
+
+func paginate[T any](items []T, top, skip int) []T {
+	if skip >= len(items) {
+		return []T{}
+	}
+	end := skip + top
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[skip:end]
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("request-id", fmt.Sprintf("outlooktest-%d", time.Now().UnixNano()))
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func eventStart(event *outlook.Event) time.Time {
+	return parseDateTime(event.Start)
+}
+
+func eventEnd(event *outlook.Event) time.Time {
+	return parseDateTime(event.End)
+}
+
+// parseDateTime parses a graph dateTimeTimeZone, returning the zero time when it is missing or invalid.
+func parseDateTime(dt *outlook.DateTimeTimeZone) time.Time {
+	t, err := dt.ToTime()
+	if err != nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
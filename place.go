@@ -0,0 +1,212 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PlaceService manages communication with microsofts graph for place resources such as rooms and room lists.
+type PlaceService struct {
+	session  *Session
+	basePath string
+}
+
+// NewPlaceService returns a new instance of a PlaceService. Places belong to the tenant rather than a user, so requests are made from the api root.
+func NewPlaceService(session *Session) *PlaceService {
+	return &PlaceService{
+		session:  session.withBasePath(""),
+		basePath: "/places",
+	}
+}
+
+// RoomListCall struct allowing for fluent style configuration of calls to the places room endpoint.
+type RoomListCall struct {
+	service    *PlaceService
+	roomList   string
+	nextLink   string
+	maxResults int64
+}
+
+// Rooms returns a RoomListCall builder struct
+func (ps *PlaceService) Rooms() *RoomListCall {
+	return &RoomListCall{
+		service:    ps,
+		maxResults: 100,
+	}
+}
+
+// RoomList limits the call to the rooms in the room list with the given email address.
+func (rlc *RoomListCall) RoomList(emailAddress string) *RoomListCall {
+	rlc.roomList = emailAddress
+	return rlc
+}
+
+// MaxResults sets the $top query parameter for the room list call.
+func (rlc *RoomListCall) MaxResults(pageSize int64) *RoomListCall {
+	rlc.maxResults = pageSize
+	return rlc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the room list call.
+func (rlc *RoomListCall) NextLink(link string) *RoomListCall {
+	rlc.nextLink = link
+	return rlc
+}
+
+// Do executes the room list call, returning the room list result.
+func (rlc *RoomListCall) Do(ctx context.Context, opts ...RequestOption) (*RoomListResult, error) {
+	params := map[string]interface{}{
+		"$top": rlc.maxResults,
+	}
+	if rlc.nextLink != "" {
+		params["$skip"] = parsePageLink(rlc.nextLink, "$skip")
+	}
+
+	var path string
+	if rlc.roomList != "" {
+		path = fmt.Sprintf("%s/%s/microsoft.graph.roomlist/rooms", rlc.service.basePath, rlc.roomList)
+	} else {
+		path = fmt.Sprintf("%s/microsoft.graph.room", rlc.service.basePath)
+	}
+
+	var result RoomListResult
+	if _, err := rlc.service.session.Get(ctx, path, params, &result, opts...); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RoomListListCall struct allowing for fluent style configuration of calls to the places roomList endpoint.
+type RoomListListCall struct {
+	service    *PlaceService
+	nextLink   string
+	maxResults int64
+}
+
+// RoomLists returns a RoomListListCall builder struct
+func (ps *PlaceService) RoomLists() *RoomListListCall {
+	return &RoomListListCall{
+		service:    ps,
+		maxResults: 100,
+	}
+}
+
+// MaxResults sets the $top query parameter for the room list list call.
+func (rllc *RoomListListCall) MaxResults(pageSize int64) *RoomListListCall {
+	rllc.maxResults = pageSize
+	return rllc
+}
+
+// NextLink uses the link provided to set the $skip query parameter for the room list list call.
+func (rllc *RoomListListCall) NextLink(link string) *RoomListListCall {
+	rllc.nextLink = link
+	return rllc
+}
+
+// Do executes the room list list call, returning the room list list result.
+func (rllc *RoomListListCall) Do(ctx context.Context, opts ...RequestOption) (*RoomListListResult, error) {
+	params := map[string]interface{}{
+		"$top": rllc.maxResults,
+	}
+	if rllc.nextLink != "" {
+		params["$skip"] = parsePageLink(rllc.nextLink, "$skip")
+	}
+
+	path := fmt.Sprintf("%s/microsoft.graph.roomlist", rllc.service.basePath)
+
+	var result RoomListListResult
+	if _, err := rllc.service.session.Get(ctx, path, params, &result, opts...); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RoomCriteria describes the requirements a room must meet to be returned by FindRooms. Zero values place no restriction.
+type RoomCriteria struct {
+	RoomList                    string
+	MinCapacity                 int
+	Building                    string
+	Floor                       *int
+	RequireAudio                bool
+	RequireVideo                bool
+	RequireDisplay              bool
+	RequireWheelChairAccessible bool
+	Tags                        []string
+}
+
+// Matches reports whether the room satisfies the criteria.
+func (rc *RoomCriteria) Matches(room *Room) bool {
+	if room.Capacity < rc.MinCapacity {
+		return false
+	}
+	if rc.Building != "" && !strings.EqualFold(room.Building, rc.Building) {
+		return false
+	}
+	if rc.Floor != nil && room.FloorNumber != *rc.Floor {
+		return false
+	}
+	if rc.RequireAudio && room.AudioDeviceName == "" {
+		return false
+	}
+	if rc.RequireVideo && room.VideoDeviceName == "" {
+		return false
+	}
+	if rc.RequireDisplay && room.DisplayDeviceName == "" {
+		return false
+	}
+	if rc.RequireWheelChairAccessible && !room.IsWheelChairAccessible {
+		return false
+	}
+	for _, tag := range rc.Tags {
+		found := false
+		for _, roomTag := range room.Tags {
+			if strings.EqualFold(roomTag, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// FindRooms pages through every room (or every room in criteria.RoomList) and returns those matching the criteria,
+// best fit first: the smallest rooms that still satisfy the capacity requirement, then by name.
+func (ps *PlaceService) FindRooms(ctx context.Context, criteria *RoomCriteria) ([]*Room, error) {
+	if criteria == nil {
+		criteria = &RoomCriteria{}
+	}
+
+	var rooms []*Room
+	call := ps.Rooms().RoomList(criteria.RoomList)
+	for {
+		result, err := call.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, room := range result.Value {
+			if criteria.Matches(room) {
+				rooms = append(rooms, room)
+			}
+		}
+		if result.NextLink == "" {
+			break
+		}
+		call.NextLink(result.NextLink)
+	}
+
+	sort.SliceStable(rooms, func(i, j int) bool {
+		if rooms[i].Capacity != rooms[j].Capacity {
+			return rooms[i].Capacity < rooms[j].Capacity
+		}
+		return rooms[i].DisplayName < rooms[j].DisplayName
+	})
+
+	return rooms, nil
+}
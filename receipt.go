@@ -0,0 +1,109 @@
+package outlook
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MAPI properties read from report messages, which graph has no first class properties for.
+const (
+	// MessageClassPropertyID PidTagMessageClass, the message's item class, e.g. "IPM.Note" or one of the MessageClass constants.
+	MessageClassPropertyID = "String 0x001A"
+	// OriginalSubjectPropertyID PidTagOriginalSubject, the subject of the message a report is about.
+	OriginalSubjectPropertyID = "String 0x0049"
+)
+
+// MessageClass values of the report messages exchange delivers as read and delivery receipts.
+const (
+	MessageClassReadReceipt        = "REPORT.IPM.Note.IPNRN"
+	MessageClassNotReadReceipt     = "REPORT.IPM.Note.IPNNRN"
+	MessageClassDeliveryReceipt    = "REPORT.IPM.Note.DR"
+	MessageClassNonDeliveryReceipt = "REPORT.IPM.Note.NDR"
+)
+
+// ReceiptKind what a receipt notification reports about the message it is about.
+type ReceiptKind string
+
+// ReceiptKind enum
+const (
+	ReceiptKindRead         ReceiptKind = "read"
+	ReceiptKindNotRead      ReceiptKind = "notRead"
+	ReceiptKindDelivered    ReceiptKind = "delivered"
+	ReceiptKindNotDelivered ReceiptKind = "notDelivered"
+)
+
+var receiptKinds = map[string]ReceiptKind{
+	strings.ToUpper(MessageClassReadReceipt):        ReceiptKindRead,
+	strings.ToUpper(MessageClassNotReadReceipt):     ReceiptKindNotRead,
+	strings.ToUpper(MessageClassDeliveryReceipt):    ReceiptKindDelivered,
+	strings.ToUpper(MessageClassNonDeliveryReceipt): ReceiptKindNotDelivered,
+}
+
+// receiptProperties the extended properties Receipt needs read along with a message.
+var receiptProperties = []string{MessageClassPropertyID, OriginalSubjectPropertyID}
+
+// singleValuePropertiesExpand returns the $expand query parameter reading the given extended properties along with an item.
+func singleValuePropertiesExpand(ids []string) string {
+	clauses := make([]string, len(ids))
+	for i, id := range ids {
+		clauses[i] = fmt.Sprintf("id eq '%s'", id)
+	}
+	return fmt.Sprintf("singleValueExtendedProperties($filter=%s)", strings.Join(clauses, " or "))
+}
+
+// receiptFilter the $filter limiting a message list to report messages.
+var receiptFilter = func() string {
+	classes := make([]string, 0, len(receiptKinds))
+	for _, class := range []string{MessageClassReadReceipt, MessageClassNotReadReceipt, MessageClassDeliveryReceipt, MessageClassNonDeliveryReceipt} {
+		classes = append(classes, fmt.Sprintf("ep/value eq '%s'", class))
+	}
+	return fmt.Sprintf("singleValueExtendedProperties/Any(ep: ep/id eq '%s' and (%s))", MessageClassPropertyID, strings.Join(classes, " or "))
+}()
+
+// Receipt a read or delivery receipt notification, parsed from the report message exchange delivers to the sender of a
+// message sent with IsReadReceiptRequested or IsDeliveryReceiptRequested.
+type Receipt struct {
+	// Kind what the receipt reports.
+	Kind ReceiptKind
+	// ReportID the id of the report message the receipt was parsed from.
+	ReportID string
+	// From who sent the report: the reader for read receipts, the reporting mail system for delivery receipts.
+	From *Recipient
+	// Subject the subject of the message the receipt is about, empty if the report did not record it.
+	Subject string
+	// ReceivedOn when the report was received.
+	ReceivedOn string
+}
+
+// Property returns the value of the message's extended property with the given id, or empty if the message was not read
+// with it expanded.
+func (m *Message) Property(id string) string {
+	for _, property := range m.SingleValueExtendedProperties {
+		if strings.EqualFold(property.ID, id) {
+			return property.Value
+		}
+	}
+	return ""
+}
+
+// MessageClass returns the message's item class, e.g. MessageClassReadReceipt. It is only known for messages read with
+// MessageListCall.Receipts or MessageGetCall.ExpandReceipt, and empty otherwise.
+func (m *Message) MessageClass() string {
+	return m.Property(MessageClassPropertyID)
+}
+
+// Receipt returns the receipt notification the message carries, or nil if it is not a read or delivery report. The message
+// must have been read with MessageListCall.Receipts or MessageGetCall.ExpandReceipt.
+func (m *Message) Receipt() *Receipt {
+	kind, ok := receiptKinds[strings.ToUpper(m.MessageClass())]
+	if !ok {
+		return nil
+	}
+	return &Receipt{
+		Kind:       kind,
+		ReportID:   m.ID,
+		From:       m.From,
+		Subject:    m.Property(OriginalSubjectPropertyID),
+		ReceivedOn: m.ReceivedOn,
+	}
+}
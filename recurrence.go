@@ -0,0 +1,543 @@
+package outlook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// RecurrenceDateFormat the date format used by microsoft for recurrence range start and end dates
+	RecurrenceDateFormat = "2006-01-02"
+
+	rruleDateFormat     = "20060102"
+	rruleDateTimeFormat = "20060102T150405Z"
+)
+
+var (
+	rruleDays = map[string]string{
+		DayOfWeekSunday:    "SU",
+		DayOfWeekMonday:    "MO",
+		DayOfWeekTuesday:   "TU",
+		DayOfWeekWednesday: "WE",
+		DayOfWeekThursday:  "TH",
+		DayOfWeekFriday:    "FR",
+		DayOfWeekSaturday:  "SA",
+	}
+
+	rruleIndexes = map[string]int{
+		RecurrencePatternIndexFirst:  1,
+		RecurrencePatternIndexSecond: 2,
+		RecurrencePatternIndexThird:  3,
+		RecurrencePatternIndexFourth: 4,
+		RecurrencePatternIndexLast:   -1,
+	}
+)
+
+// RecurrenceBuilder struct allowing for fluent style construction of a PatternedRecurrence.
+type RecurrenceBuilder struct {
+	pattern *RecurrencePattern
+	rng     *RecurrenceRange
+}
+
+// NewRecurrence returns a new RecurrenceBuilder. Without further configuration it describes a daily recurrence with no end.
+func NewRecurrence() *RecurrenceBuilder {
+	return &RecurrenceBuilder{
+		pattern: &RecurrencePattern{
+			Type:     RecurrencePatternTypeDaily,
+			Interval: 1,
+		},
+		rng: &RecurrenceRange{
+			Type: RecurrenceRangeTypeNoEnd,
+		},
+	}
+}
+
+// Daily sets the recurrence to repeat every interval days.
+func (rb *RecurrenceBuilder) Daily(interval int) *RecurrenceBuilder {
+	rb.pattern = &RecurrencePattern{
+		Type:     RecurrencePatternTypeDaily,
+		Interval: interval,
+	}
+	return rb
+}
+
+// Weekly sets the recurrence to repeat on the given days every interval weeks.
+func (rb *RecurrenceBuilder) Weekly(interval int, days ...string) *RecurrenceBuilder {
+	rb.pattern = &RecurrencePattern{
+		Type:       RecurrencePatternTypeWeekly,
+		Interval:   interval,
+		DaysOfWeek: days,
+	}
+	return rb
+}
+
+// MonthlyOnDay sets the recurrence to repeat on the given day of the month every interval months.
+func (rb *RecurrenceBuilder) MonthlyOnDay(interval, dayOfMonth int) *RecurrenceBuilder {
+	rb.pattern = &RecurrencePattern{
+		Type:       RecurrencePatternTypeAbsoluteMonthly,
+		Interval:   interval,
+		DayOfMonth: dayOfMonth,
+	}
+	return rb
+}
+
+// RelativeMonthly sets the recurrence to repeat on the indexed weekday (e.g. the second tuesday) every interval months.
+func (rb *RecurrenceBuilder) RelativeMonthly(interval int, index string, days ...string) *RecurrenceBuilder {
+	rb.pattern = &RecurrencePattern{
+		Type:       RecurrencePatternTypeRelativeMonthly,
+		Interval:   interval,
+		Index:      index,
+		DaysOfWeek: days,
+	}
+	return rb
+}
+
+// YearlyOnDay sets the recurrence to repeat on the given month and day of the month every interval years.
+func (rb *RecurrenceBuilder) YearlyOnDay(interval, month, dayOfMonth int) *RecurrenceBuilder {
+	rb.pattern = &RecurrencePattern{
+		Type:       RecurrencePatternTypeAbsoluteYearly,
+		Interval:   interval,
+		Month:      month,
+		DayOfMonth: dayOfMonth,
+	}
+	return rb
+}
+
+// RelativeYearly sets the recurrence to repeat on the indexed weekday of the given month every interval years.
+func (rb *RecurrenceBuilder) RelativeYearly(interval, month int, index string, days ...string) *RecurrenceBuilder {
+	rb.pattern = &RecurrencePattern{
+		Type:       RecurrencePatternTypeRelativeYearly,
+		Interval:   interval,
+		Month:      month,
+		Index:      index,
+		DaysOfWeek: days,
+	}
+	return rb
+}
+
+// FirstDayOfWeek sets the first day of the week used by weekly recurrences.
+func (rb *RecurrenceBuilder) FirstDayOfWeek(day string) *RecurrenceBuilder {
+	rb.pattern.FirstDayOfWeek = day
+	return rb
+}
+
+// StartDate sets the date the recurrence range begins on.
+func (rb *RecurrenceBuilder) StartDate(start time.Time) *RecurrenceBuilder {
+	rb.rng.StartDate = start.Format(RecurrenceDateFormat)
+	return rb
+}
+
+// EndDate sets the recurrence range to end on the given date.
+func (rb *RecurrenceBuilder) EndDate(end time.Time) *RecurrenceBuilder {
+	rb.rng.Type = RecurrenceRangeTypeEndDate
+	rb.rng.EndDate = end.Format(RecurrenceDateFormat)
+	rb.rng.NumberOfOccurrences = 0
+	return rb
+}
+
+// Occurrences sets the recurrence range to end after the given number of occurrences.
+func (rb *RecurrenceBuilder) Occurrences(count int) *RecurrenceBuilder {
+	rb.rng.Type = RecurrenceRangeTypeNumbered
+	rb.rng.NumberOfOccurrences = count
+	rb.rng.EndDate = ""
+	return rb
+}
+
+// NoEnd sets the recurrence range to repeat indefinitely.
+func (rb *RecurrenceBuilder) NoEnd() *RecurrenceBuilder {
+	rb.rng.Type = RecurrenceRangeTypeNoEnd
+	rb.rng.EndDate = ""
+	rb.rng.NumberOfOccurrences = 0
+	return rb
+}
+
+// TimeZone sets the time zone the recurrence range dates are expressed in.
+func (rb *RecurrenceBuilder) TimeZone(tz string) *RecurrenceBuilder {
+	rb.rng.RecurrenceTimezone = tz
+	return rb
+}
+
+// Build validates the configured recurrence and returns the resulting PatternedRecurrence.
+func (rb *RecurrenceBuilder) Build() (*PatternedRecurrence, error) {
+	pattern := *rb.pattern
+	rng := *rb.rng
+	recurrence := &PatternedRecurrence{Pattern: &pattern, Range: &rng}
+	if err := recurrence.Validate(); err != nil {
+		return nil, err
+	}
+	return recurrence, nil
+}
+
+// Validate checks that the recurrence carries the fields microsoft requires for its pattern and range types.
+func (pr *PatternedRecurrence) Validate() error {
+	if pr.Pattern == nil {
+		return fmt.Errorf("recurrence: missing pattern")
+	}
+	if pr.Range == nil {
+		return fmt.Errorf("recurrence: missing range")
+	}
+
+	p := pr.Pattern
+	if p.Interval < 1 {
+		return fmt.Errorf("recurrence: interval must be at least 1, got %d", p.Interval)
+	}
+	for _, day := range p.DaysOfWeek {
+		if _, ok := rruleDays[day]; !ok {
+			return fmt.Errorf("recurrence: unknown day of week %q", day)
+		}
+	}
+	switch p.Type {
+	case RecurrencePatternTypeDaily:
+	case RecurrencePatternTypeWeekly:
+		if len(p.DaysOfWeek) == 0 {
+			return fmt.Errorf("recurrence: weekly pattern requires at least one day of week")
+		}
+	case RecurrencePatternTypeAbsoluteMonthly:
+		if p.DayOfMonth < 1 || p.DayOfMonth > 31 {
+			return fmt.Errorf("recurrence: invalid day of month %d", p.DayOfMonth)
+		}
+	case RecurrencePatternTypeRelativeMonthly:
+		if len(p.DaysOfWeek) == 0 {
+			return fmt.Errorf("recurrence: relative monthly pattern requires at least one day of week")
+		}
+	case RecurrencePatternTypeAbsoluteYearly:
+		if p.Month < 1 || p.Month > 12 {
+			return fmt.Errorf("recurrence: invalid month %d", p.Month)
+		}
+		if p.DayOfMonth < 1 || p.DayOfMonth > 31 {
+			return fmt.Errorf("recurrence: invalid day of month %d", p.DayOfMonth)
+		}
+	case RecurrencePatternTypeRelativeYearly:
+		if p.Month < 1 || p.Month > 12 {
+			return fmt.Errorf("recurrence: invalid month %d", p.Month)
+		}
+		if len(p.DaysOfWeek) == 0 {
+			return fmt.Errorf("recurrence: relative yearly pattern requires at least one day of week")
+		}
+	default:
+		return fmt.Errorf("recurrence: unknown pattern type %q", p.Type)
+	}
+
+	r := pr.Range
+	if r.StartDate == "" {
+		return fmt.Errorf("recurrence: range requires a start date")
+	}
+	switch r.Type {
+	case RecurrenceRangeTypeNoEnd:
+	case RecurrenceRangeTypeEndDate:
+		if r.EndDate == "" {
+			return fmt.Errorf("recurrence: endDate range requires an end date")
+		}
+	case RecurrenceRangeTypeNumbered:
+		if r.NumberOfOccurrences < 1 {
+			return fmt.Errorf("recurrence: numbered range requires at least one occurrence")
+		}
+	default:
+		return fmt.Errorf("recurrence: unknown range type %q", r.Type)
+	}
+
+	return nil
+}
+
+// RRule converts the recurrence into an iCalendar RRULE value (without the "RRULE:" prefix) for an all day series, whose
+// DTSTART is a DATE: an end date is written as an UNTIL date. Timed series must use RRuleTimed.
+func (pr *PatternedRecurrence) RRule() (string, error) {
+	return pr.rrule(nil)
+}
+
+// RRuleTimed converts the recurrence into an iCalendar RRULE value for a series of timed events, whose DTSTART is a DATE-TIME.
+// RFC 5545 then requires UNTIL to be a UTC date time, so an end date is written as the end of that day in loc, the series'
+// time zone, converted to UTC. The range's RecurrenceTimezone, when set, takes precedence over loc.
+func (pr *PatternedRecurrence) RRuleTimed(loc *time.Location) (string, error) {
+	if pr.Range != nil && pr.Range.RecurrenceTimezone != "" {
+		rangeLoc, err := LoadTimeZone(pr.Range.RecurrenceTimezone)
+		if err != nil {
+			return "", fmt.Errorf("recurrence: %w", err)
+		}
+		loc = rangeLoc
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return pr.rrule(loc)
+}
+
+// rrule converts the recurrence into an RRULE value, writing UNTIL as a date, or as a UTC date time if untilLoc is set.
+func (pr *PatternedRecurrence) rrule(untilLoc *time.Location) (string, error) {
+	if pr.Pattern == nil {
+		return "", fmt.Errorf("recurrence: missing pattern")
+	}
+	p := pr.Pattern
+
+	var parts []string
+	switch p.Type {
+	case RecurrencePatternTypeDaily:
+		parts = append(parts, "FREQ=DAILY")
+	case RecurrencePatternTypeWeekly:
+		parts = append(parts, "FREQ=WEEKLY")
+	case RecurrencePatternTypeAbsoluteMonthly, RecurrencePatternTypeRelativeMonthly:
+		parts = append(parts, "FREQ=MONTHLY")
+	case RecurrencePatternTypeAbsoluteYearly, RecurrencePatternTypeRelativeYearly:
+		parts = append(parts, "FREQ=YEARLY")
+	default:
+		return "", fmt.Errorf("recurrence: unknown pattern type %q", p.Type)
+	}
+
+	if p.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", p.Interval))
+	}
+
+	if len(p.DaysOfWeek) > 0 {
+		days := make([]string, 0, len(p.DaysOfWeek))
+		for _, day := range p.DaysOfWeek {
+			abbr, ok := rruleDays[day]
+			if !ok {
+				return "", fmt.Errorf("recurrence: unknown day of week %q", day)
+			}
+			days = append(days, abbr)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	switch p.Type {
+	case RecurrencePatternTypeAbsoluteMonthly:
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", p.DayOfMonth))
+	case RecurrencePatternTypeAbsoluteYearly:
+		parts = append(parts, fmt.Sprintf("BYMONTH=%d", p.Month), fmt.Sprintf("BYMONTHDAY=%d", p.DayOfMonth))
+	case RecurrencePatternTypeRelativeYearly:
+		parts = append(parts, fmt.Sprintf("BYMONTH=%d", p.Month))
+	}
+
+	if p.Type == RecurrencePatternTypeRelativeMonthly || p.Type == RecurrencePatternTypeRelativeYearly {
+		index := p.Index
+		if index == "" {
+			index = RecurrencePatternIndexFirst
+		}
+		pos, ok := rruleIndexes[index]
+		if !ok {
+			return "", fmt.Errorf("recurrence: unknown index %q", p.Index)
+		}
+		parts = append(parts, fmt.Sprintf("BYSETPOS=%d", pos))
+	}
+
+	if p.FirstDayOfWeek != "" {
+		abbr, ok := rruleDays[p.FirstDayOfWeek]
+		if !ok {
+			return "", fmt.Errorf("recurrence: unknown day of week %q", p.FirstDayOfWeek)
+		}
+		parts = append(parts, "WKST="+abbr)
+	}
+
+	if r := pr.Range; r != nil {
+		switch r.Type {
+		case RecurrenceRangeTypeEndDate:
+			end, err := time.Parse(RecurrenceDateFormat, r.EndDate)
+			if err != nil {
+				return "", fmt.Errorf("recurrence: invalid end date %q: %w", r.EndDate, err)
+			}
+			if untilLoc == nil {
+				parts = append(parts, "UNTIL="+end.Format(rruleDateFormat))
+				break
+			}
+			lastSecond := time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 0, untilLoc)
+			parts = append(parts, "UNTIL="+lastSecond.UTC().Format(rruleDateTimeFormat))
+		case RecurrenceRangeTypeNumbered:
+			parts = append(parts, fmt.Sprintf("COUNT=%d", r.NumberOfOccurrences))
+		}
+	}
+
+	return strings.Join(parts, ";"), nil
+}
+
+// ParseRRule converts an iCalendar RRULE value into a PatternedRecurrence whose range begins on start.
+// The value may optionally carry the "RRULE:" prefix.
+func ParseRRule(rule string, start time.Time) (*PatternedRecurrence, error) {
+	rule = strings.TrimSpace(rule)
+	rule = strings.TrimPrefix(strings.TrimPrefix(rule, "RRULE:"), "rrule:")
+	if rule == "" {
+		return nil, fmt.Errorf("rrule: empty rule")
+	}
+
+	fields := map[string]string{}
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("rrule: malformed part %q", part)
+		}
+		fields[strings.ToUpper(kv[0])] = strings.ToUpper(kv[1])
+	}
+
+	pattern := &RecurrencePattern{Interval: 1}
+	rng := &RecurrenceRange{
+		Type:      RecurrenceRangeTypeNoEnd,
+		StartDate: start.Format(RecurrenceDateFormat),
+	}
+
+	if raw, ok := fields["INTERVAL"]; ok {
+		interval, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("rrule: invalid INTERVAL %q: %w", raw, err)
+		}
+		pattern.Interval = interval
+	}
+
+	if raw, ok := fields["WKST"]; ok {
+		day, err := parseRRuleDay(raw)
+		if err != nil {
+			return nil, err
+		}
+		pattern.FirstDayOfWeek = day
+	}
+
+	index := ""
+	if raw, ok := fields["BYDAY"]; ok {
+		for _, entry := range strings.Split(raw, ",") {
+			// Entries may be prefixed with an ordinal, e.g. 2TU or -1FR.
+			split := strings.IndexFunc(entry, func(r rune) bool { return r >= 'A' && r <= 'Z' })
+			if split < 0 {
+				return nil, fmt.Errorf("rrule: invalid BYDAY entry %q", entry)
+			}
+			if split > 0 {
+				entryIndex, err := parseRRuleIndex(entry[:split])
+				if err != nil {
+					return nil, err
+				}
+				index = entryIndex
+			}
+			day, err := parseRRuleDay(entry[split:])
+			if err != nil {
+				return nil, err
+			}
+			pattern.DaysOfWeek = append(pattern.DaysOfWeek, day)
+		}
+	}
+	if raw, ok := fields["BYSETPOS"]; ok {
+		setIndex, err := parseRRuleIndex(raw)
+		if err != nil {
+			return nil, err
+		}
+		index = setIndex
+	}
+
+	if raw, ok := fields["BYMONTHDAY"]; ok {
+		day, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("rrule: invalid BYMONTHDAY %q: %w", raw, err)
+		}
+		pattern.DayOfMonth = day
+	}
+	if raw, ok := fields["BYMONTH"]; ok {
+		month, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("rrule: invalid BYMONTH %q: %w", raw, err)
+		}
+		pattern.Month = month
+	}
+
+	switch fields["FREQ"] {
+	case "DAILY":
+		pattern.Type = RecurrencePatternTypeDaily
+	case "WEEKLY":
+		pattern.Type = RecurrencePatternTypeWeekly
+		if len(pattern.DaysOfWeek) == 0 {
+			pattern.DaysOfWeek = []string{dayOfWeek(start.Weekday())}
+		}
+	case "MONTHLY":
+		if len(pattern.DaysOfWeek) > 0 {
+			pattern.Type = RecurrencePatternTypeRelativeMonthly
+			pattern.Index = index
+		} else {
+			pattern.Type = RecurrencePatternTypeAbsoluteMonthly
+			if pattern.DayOfMonth == 0 {
+				pattern.DayOfMonth = start.Day()
+			}
+		}
+	case "YEARLY":
+		if pattern.Month == 0 {
+			pattern.Month = int(start.Month())
+		}
+		if len(pattern.DaysOfWeek) > 0 {
+			pattern.Type = RecurrencePatternTypeRelativeYearly
+			pattern.Index = index
+		} else {
+			pattern.Type = RecurrencePatternTypeAbsoluteYearly
+			if pattern.DayOfMonth == 0 {
+				pattern.DayOfMonth = start.Day()
+			}
+		}
+	case "":
+		return nil, fmt.Errorf("rrule: missing FREQ")
+	default:
+		return nil, fmt.Errorf("rrule: unsupported FREQ %q", fields["FREQ"])
+	}
+
+	if pattern.Index == "" && (pattern.Type == RecurrencePatternTypeRelativeMonthly || pattern.Type == RecurrencePatternTypeRelativeYearly) {
+		pattern.Index = RecurrencePatternIndexFirst
+	}
+
+	if raw, ok := fields["UNTIL"]; ok {
+		until, err := parseRRuleUntil(raw)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasSuffix(raw, "Z") {
+			// A UTC UNTIL, such as RRuleTimed writes, falls on the last day of the series in the series' own time zone.
+			until = until.In(start.Location())
+		}
+		rng.Type = RecurrenceRangeTypeEndDate
+		rng.EndDate = until.Format(RecurrenceDateFormat)
+	} else if raw, ok := fields["COUNT"]; ok {
+		count, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("rrule: invalid COUNT %q: %w", raw, err)
+		}
+		rng.Type = RecurrenceRangeTypeNumbered
+		rng.NumberOfOccurrences = count
+	}
+
+	recurrence := &PatternedRecurrence{Pattern: pattern, Range: rng}
+	if err := recurrence.Validate(); err != nil {
+		return nil, err
+	}
+	return recurrence, nil
+}
+
+func parseRRuleDay(abbr string) (string, error) {
+	for day, dayAbbr := range rruleDays {
+		if dayAbbr == abbr {
+			return day, nil
+		}
+	}
+	return "", fmt.Errorf("rrule: unknown day %q", abbr)
+}
+
+func parseRRuleIndex(raw string) (string, error) {
+	pos, err := strconv.Atoi(strings.TrimPrefix(raw, "+"))
+	if err != nil {
+		return "", fmt.Errorf("rrule: invalid position %q: %w", raw, err)
+	}
+	for index, indexPos := range rruleIndexes {
+		if indexPos == pos {
+			return index, nil
+		}
+	}
+	return "", fmt.Errorf("rrule: unsupported position %d", pos)
+}
+
+func parseRRuleUntil(raw string) (time.Time, error) {
+	for _, layout := range []string{rruleDateTimeFormat, "20060102T150405", rruleDateFormat} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("rrule: invalid UNTIL %q", raw)
+}
+
+func dayOfWeek(day time.Weekday) string {
+	return strings.ToLower(day.String())
+}
@@ -0,0 +1,54 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ReminderService manages communication with microsofts graph for reminder resources.
+type ReminderService struct {
+	session  *Session
+	basePath string
+}
+
+// NewReminderService returns a new instance of a ReminderService.
+func NewReminderService(session *Session) *ReminderService {
+	return &ReminderService{
+		session:  session,
+		basePath: "/reminderView",
+	}
+}
+
+// ReminderViewCall struct allowing for fluent style configuration of calls to the reminderView endpoint.
+type ReminderViewCall struct {
+	service   *ReminderService
+	startTime time.Time
+	endTime   time.Time
+}
+
+// View returns a ReminderViewCall listing the reminders which fire between start and end.
+func (rs *ReminderService) View(start, end time.Time) *ReminderViewCall {
+	return &ReminderViewCall{
+		service:   rs,
+		startTime: start,
+		endTime:   end,
+	}
+}
+
+// Do executes the reminder view call, returning the reminder list result.
+func (rvc *ReminderViewCall) Do(ctx context.Context, opts ...RequestOption) (*ReminderListResult, error) {
+	path := fmt.Sprintf(
+		"%s(startDateTime='%s',endDateTime='%s')",
+		rvc.service.basePath,
+		rvc.startTime.UTC().Format(DefaultQueryDateTimeFormat),
+		rvc.endTime.UTC().Format(DefaultQueryDateTimeFormat),
+	)
+
+	var result ReminderListResult
+	if _, err := rvc.service.session.Get(ctx, path, nil, &result, opts...); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
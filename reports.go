@@ -0,0 +1,295 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReportPeriod the number of days a usage report aggregates over.
+type ReportPeriod string
+
+// Periods usage reports can be requested for.
+const (
+	ReportPeriodD7   ReportPeriod = "D7"
+	ReportPeriodD30  ReportPeriod = "D30"
+	ReportPeriodD90  ReportPeriod = "D90"
+	ReportPeriodD180 ReportPeriod = "D180"
+)
+
+// ReportDateFormat the format of the date detail reports can be requested for, and of the dates in every report.
+const ReportDateFormat = "2006-01-02"
+
+// ReportsService manages communication with microsofts graph for the tenant's microsoft 365 usage reports. Reports belong to
+// the tenant rather than a user, so requests are made from the api root, and need the Reports.Read.All permission.
+// Unless the tenant turned off concealed names, user names, addresses, and display names in reports are anonymized.
+type ReportsService struct {
+	session  *Session
+	basePath string
+}
+
+// NewReportsService returns a new instance of a ReportsService.
+func NewReportsService(session *Session) *ReportsService {
+	return &ReportsService{
+		session:  session.withBasePath(""),
+		basePath: "/reports",
+	}
+}
+
+// EmailActivityUserDetail a row of the email activity user detail report, the activity of a single user.
+type EmailActivityUserDetail struct {
+	ReportRefreshDate      string   `json:"reportRefreshDate,omitempty" csv:"Report Refresh Date"`
+	UserPrincipalName      string   `json:"userPrincipalName,omitempty" csv:"User Principal Name"`
+	DisplayName            string   `json:"displayName,omitempty" csv:"Display Name"`
+	IsDeleted              bool     `json:"isDeleted,omitempty" csv:"Is Deleted"`
+	DeletedDate            string   `json:"deletedDate,omitempty" csv:"Deleted Date"`
+	LastActivityDate       string   `json:"lastActivityDate,omitempty" csv:"Last Activity Date"`
+	SendCount              int64    `json:"sendCount,omitempty" csv:"Send Count"`
+	ReceiveCount           int64    `json:"receiveCount,omitempty" csv:"Receive Count"`
+	ReadCount              int64    `json:"readCount,omitempty" csv:"Read Count"`
+	MeetingCreatedCount    int64    `json:"meetingCreatedCount,omitempty" csv:"Meeting Created Count"`
+	MeetingInteractedCount int64    `json:"meetingInteractedCount,omitempty" csv:"Meeting Interacted Count"`
+	AssignedProducts       []string `json:"assignedProducts,omitempty" csv:"Assigned Products"`
+	ReportPeriod           string   `json:"reportPeriod,omitempty" csv:"Report Period"`
+}
+
+// EmailActivityCounts a row of the email activity counts report, the tenant's activity on a single day. As a row of the
+// email activity user counts report, each count is instead the number of users with that activity on the day.
+type EmailActivityCounts struct {
+	ReportRefreshDate string `json:"reportRefreshDate,omitempty" csv:"Report Refresh Date"`
+	Send              int64  `json:"send,omitempty" csv:"Send"`
+	Receive           int64  `json:"receive,omitempty" csv:"Receive"`
+	Read              int64  `json:"read,omitempty" csv:"Read"`
+	MeetingCreated    int64  `json:"meetingCreated,omitempty" csv:"Meeting Created"`
+	MeetingInteracted int64  `json:"meetingInteracted,omitempty" csv:"Meeting Interacted"`
+	ReportDate        string `json:"reportDate,omitempty" csv:"Report Date"`
+	ReportPeriod      string `json:"reportPeriod,omitempty" csv:"Report Period"`
+}
+
+// EmailAppUsageUserDetail a row of the email app usage user detail report, the mail clients a single user used.
+type EmailAppUsageUserDetail struct {
+	ReportRefreshDate string `json:"reportRefreshDate,omitempty" csv:"Report Refresh Date"`
+	UserPrincipalName string `json:"userPrincipalName,omitempty" csv:"User Principal Name"`
+	DisplayName       string `json:"displayName,omitempty" csv:"Display Name"`
+	IsDeleted         bool   `json:"isDeleted,omitempty" csv:"Is Deleted"`
+	DeletedDate       string `json:"deletedDate,omitempty" csv:"Deleted Date"`
+	LastActivityDate  string `json:"lastActivityDate,omitempty" csv:"Last Activity Date"`
+	MailForMac        string `json:"mailForMac,omitempty" csv:"Mail For Mac"`
+	OutlookForMac     string `json:"outlookForMac,omitempty" csv:"Outlook For Mac"`
+	OutlookForWindows string `json:"outlookForWindows,omitempty" csv:"Outlook For Windows"`
+	OutlookForMobile  string `json:"outlookForMobile,omitempty" csv:"Outlook For Mobile"`
+	OtherForMobile    string `json:"otherForMobile,omitempty" csv:"Other For Mobile"`
+	OutlookForWeb     string `json:"outlookForWeb,omitempty" csv:"Outlook For Web"`
+	POP3App           string `json:"pop3App,omitempty" csv:"POP3 App"`
+	IMAP4App          string `json:"imap4App,omitempty" csv:"IMAP4 App"`
+	SMTPApp           string `json:"smtpApp,omitempty" csv:"SMTP App"`
+	ReportPeriod      string `json:"reportPeriod,omitempty" csv:"Report Period"`
+}
+
+// MailboxUsageDetail a row of the mailbox usage detail report, the size and quotas of a single mailbox.
+type MailboxUsageDetail struct {
+	ReportRefreshDate               string `json:"reportRefreshDate,omitempty" csv:"Report Refresh Date"`
+	UserPrincipalName               string `json:"userPrincipalName,omitempty" csv:"User Principal Name"`
+	DisplayName                     string `json:"displayName,omitempty" csv:"Display Name"`
+	IsDeleted                       bool   `json:"isDeleted,omitempty" csv:"Is Deleted"`
+	DeletedDate                     string `json:"deletedDate,omitempty" csv:"Deleted Date"`
+	CreatedDate                     string `json:"createdDate,omitempty" csv:"Created Date"`
+	LastActivityDate                string `json:"lastActivityDate,omitempty" csv:"Last Activity Date"`
+	ItemCount                       int64  `json:"itemCount,omitempty" csv:"Item Count"`
+	StorageUsedInBytes              int64  `json:"storageUsedInBytes,omitempty" csv:"Storage Used (Byte)"`
+	DeletedItemCount                int64  `json:"deletedItemCount,omitempty" csv:"Deleted Item Count"`
+	DeletedItemSizeInBytes          int64  `json:"deletedItemSizeInBytes,omitempty" csv:"Deleted Item Size (Byte)"`
+	IssueWarningQuotaInBytes        int64  `json:"issueWarningQuotaInBytes,omitempty" csv:"Issue Warning Quota (Byte)"`
+	ProhibitSendQuotaInBytes        int64  `json:"prohibitSendQuotaInBytes,omitempty" csv:"Prohibit Send Quota (Byte)"`
+	ProhibitSendReceiveQuotaInBytes int64  `json:"prohibitSendReceiveQuotaInBytes,omitempty" csv:"Prohibit Send/Receive Quota (Byte)"`
+	HasArchive                      bool   `json:"hasArchive,omitempty" csv:"Has Archive"`
+	RecipientType                   string `json:"recipientType,omitempty" csv:"Recipient Type"`
+	ReportPeriod                    string `json:"reportPeriod,omitempty" csv:"Report Period"`
+}
+
+// ReportCall struct allowing for fluent style configuration of calls to a usage report function, returning rows of T.
+type ReportCall[T any] struct {
+	service  *ReportsService
+	function string
+	period   ReportPeriod
+	date     time.Time
+	json     bool
+}
+
+func newReportCall[T any](rs *ReportsService, function string, period ReportPeriod) *ReportCall[T] {
+	return &ReportCall[T]{
+		service:  rs,
+		function: function,
+		period:   period,
+	}
+}
+
+// EmailActivityUserDetail returns a ReportCall for the email activity of each user over the period.
+func (rs *ReportsService) EmailActivityUserDetail(period ReportPeriod) *ReportCall[EmailActivityUserDetail] {
+	return newReportCall[EmailActivityUserDetail](rs, "getEmailActivityUserDetail", period)
+}
+
+// EmailActivityCounts returns a ReportCall for the tenant's email activity on each day of the period.
+func (rs *ReportsService) EmailActivityCounts(period ReportPeriod) *ReportCall[EmailActivityCounts] {
+	return newReportCall[EmailActivityCounts](rs, "getEmailActivityCounts", period)
+}
+
+// EmailActivityUserCounts returns a ReportCall for the number of users with each kind of email activity on each day of the period.
+func (rs *ReportsService) EmailActivityUserCounts(period ReportPeriod) *ReportCall[EmailActivityCounts] {
+	return newReportCall[EmailActivityCounts](rs, "getEmailActivityUserCounts", period)
+}
+
+// EmailAppUsageUserDetail returns a ReportCall for the mail clients each user used over the period.
+func (rs *ReportsService) EmailAppUsageUserDetail(period ReportPeriod) *ReportCall[EmailAppUsageUserDetail] {
+	return newReportCall[EmailAppUsageUserDetail](rs, "getEmailAppUsageUserDetail", period)
+}
+
+// MailboxUsageDetail returns a ReportCall for the size and quotas of each mailbox, as of the end of the period.
+func (rs *ReportsService) MailboxUsageDetail(period ReportPeriod) *ReportCall[MailboxUsageDetail] {
+	return newReportCall[MailboxUsageDetail](rs, "getMailboxUsageDetail", period)
+}
+
+// Date requests the report for a single day, within the last 30 days, rather than for a period. Only user detail reports
+// can be requested for a day.
+func (rc *ReportCall[T]) Date(date time.Time) *ReportCall[T] {
+	rc.date = date
+	return rc
+}
+
+// JSON requests the report as json rather than csv. Graph pages json reports, which Do follows.
+func (rc *ReportCall[T]) JSON() *ReportCall[T] {
+	rc.json = true
+	return rc
+}
+
+// Do executes the report call, returning every row of the report.
+func (rc *ReportCall[T]) Do(ctx context.Context, opts ...RequestOption) ([]*T, error) {
+	if !rc.json {
+		var buf bytes.Buffer
+		if err := rc.Stream(ctx, &buf, opts...); err != nil {
+			return nil, err
+		}
+		return parseReportCSV[T](&buf)
+	}
+
+	var rows []*T
+	params := map[string]interface{}{"$format": "application/json"}
+	for {
+		var page odataCollection[*T]
+		if _, err := rc.service.session.Get(ctx, rc.path(), params, &page, opts...); err != nil {
+			return nil, err
+		}
+		rows = append(rows, page.Value...)
+		if page.NextLink == "" {
+			return rows, nil
+		}
+		parsed, err := url.Parse(page.NextLink)
+		if err != nil {
+			return nil, err
+		}
+		params = map[string]interface{}{}
+		for key, values := range parsed.Query() {
+			params[key] = values[0]
+		}
+	}
+}
+
+// Stream executes the report call, copying the report's csv to w as it is downloaded.
+func (rc *ReportCall[T]) Stream(ctx context.Context, w io.Writer, opts ...RequestOption) error {
+	_, err := rc.service.session.query(ctx, http.MethodGet, rc.path(), nil, nil, nil, w, opts...)
+	return err
+}
+
+func (rc *ReportCall[T]) path() string {
+	if !rc.date.IsZero() {
+		return fmt.Sprintf("%s/%s(date=%s)", rc.service.basePath, rc.function, rc.date.Format(ReportDateFormat))
+	}
+	return fmt.Sprintf("%s/%s(period='%s')", rc.service.basePath, rc.function, rc.period)
+}
+
+// parseReportCSV parses a report's csv into rows of T, matching columns to T's fields by their csv tags. Columns T has
+// no field for are ignored, so reports gaining columns keep parsing.
+func parseReportCSV[T any](r io.Reader) ([]*T, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("report: %w", err)
+	}
+
+	rowType := reflect.TypeOf((*T)(nil)).Elem()
+	fields := make([]int, len(header))
+	for i, name := range header {
+		fields[i] = -1
+		name = strings.TrimPrefix(strings.TrimSpace(name), "\ufeff")
+		for j := 0; j < rowType.NumField(); j++ {
+			if rowType.Field(j).Tag.Get("csv") == name {
+				fields[i] = j
+				break
+			}
+		}
+	}
+
+	var rows []*T
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("report: %w", err)
+		}
+
+		row := new(T)
+		value := reflect.ValueOf(row).Elem()
+		for i, column := range record {
+			if i >= len(fields) || fields[i] < 0 || column == "" {
+				continue
+			}
+			if err := setReportField(value.Field(fields[i]), column); err != nil {
+				return nil, fmt.Errorf("report: column %q: %w", header[i], err)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+func setReportField(field reflect.Value, column string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(column)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(column)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int64:
+		n, err := strconv.ParseInt(column, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Slice:
+		// Lists, such as assigned products, are joined with '+' in csv reports.
+		var values []string
+		for _, v := range strings.Split(column, "+") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		field.Set(reflect.ValueOf(values))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package outlook
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestOption functions to configure a single call, passed to a call's Do or to the Session's Get, Post, Patch, and Delete.
+type RequestOption func(*requestOptions)
+
+// Return preference enum, see SetRequestReturn
+const (
+	// ReturnMinimal asks graph to respond to a create or update with no body
+	ReturnMinimal = "minimal"
+	// ReturnRepresentation asks graph to respond to a create or update with the full resource
+	ReturnRepresentation = "representation"
+)
+
+type requestOptions struct {
+	header  http.Header
+	params  map[string]interface{}
+	timeout time.Duration
+}
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	ro := &requestOptions{
+		header: http.Header{},
+		params: map[string]interface{}{},
+	}
+	for _, opt := range opts {
+		opt(ro)
+	}
+	return ro
+}
+
+// SetRequestHeader returns a RequestOption which adds the given header to the request.
+func SetRequestHeader(key, value string) RequestOption {
+	return func(ro *requestOptions) {
+		ro.header.Add(key, value)
+	}
+}
+
+// SetRequestPrefer returns a RequestOption which adds a Prefer header with the given preference, e.g. `outlook.body-content-type="text"`.
+func SetRequestPrefer(preference string) RequestOption {
+	return SetRequestHeader("Prefer", preference)
+}
+
+// SetRequestReturn returns a RequestOption which sets the return preference of a create or update call, either ReturnMinimal or ReturnRepresentation.
+// With ReturnMinimal graph responds with no body, saving bandwidth for high volume writers, and the call returns the resource as it was sent,
+// without server populated fields such as its id.
+func SetRequestReturn(preference string) RequestOption {
+	return SetRequestPrefer("return=" + preference)
+}
+
+// SetRequestIfMatch returns a RequestOption which makes an update or delete conditional on the resource still having the given etag,
+// as read from its ETag field. The call fails with an error matching ErrPreconditionFailed if someone else changed it in the meantime.
+func SetRequestIfMatch(etag string) RequestOption {
+	return SetRequestHeader("If-Match", etag)
+}
+
+// SetRequestQueryParam returns a RequestOption which sets the given query parameter, e.g. $select or $filter, overriding the call's own value if any.
+func SetRequestQueryParam(key string, value interface{}) RequestOption {
+	return func(ro *requestOptions) {
+		ro.params[key] = value
+	}
+}
+
+// SetRequestTimeout returns a RequestOption which bounds the call, including any time spent queued behind the client's limiters, to timeout.
+func SetRequestTimeout(timeout time.Duration) RequestOption {
+	return func(ro *requestOptions) {
+		ro.timeout = timeout
+	}
+}
+
+// withoutQueryParams returns the options with their query parameters dropped, keeping those scoped to the request itself, such as
+// headers and timeouts, for calls which pass a caller's options on to follow up requests to other endpoints.
+func withoutQueryParams(opts []RequestOption) []RequestOption {
+	if len(opts) == 0 {
+		return nil
+	}
+	ro := newRequestOptions(opts)
+	return []RequestOption{func(target *requestOptions) {
+		for key, values := range ro.header {
+			for _, value := range values {
+				target.header.Add(key, value)
+			}
+		}
+		if ro.timeout > 0 {
+			target.timeout = ro.timeout
+		}
+	}}
+}
+
+// apply merges the options into the call's params and header, returning the context the call should run under and its cancel func.
+func (ro *requestOptions) apply(ctx context.Context, params map[string]interface{}, header http.Header) (context.Context, context.CancelFunc, map[string]interface{}, http.Header) {
+	if len(ro.params) > 0 {
+		merged := make(map[string]interface{}, len(params)+len(ro.params))
+		for key, value := range params {
+			merged[key] = value
+		}
+		for key, value := range ro.params {
+			merged[key] = value
+		}
+		params = merged
+	}
+
+	if len(ro.header) > 0 {
+		merged := header.Clone()
+		if merged == nil {
+			merged = http.Header{}
+		}
+		for key, values := range ro.header {
+			for _, value := range values {
+				merged.Add(key, value)
+			}
+		}
+		header = merged
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if ro.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, ro.timeout)
+	}
+	return ctx, cancel, params, header
+}
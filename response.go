@@ -0,0 +1,62 @@
+package outlook
+
+import (
+	"context"
+	"net/http"
+)
+
+// Response a single page of a collection returned by graph, along with the paging, delta, and correlation metadata of the response.
+type Response[T any] struct {
+	Items []T
+	// NextLink the link to the next page, empty on the last page.
+	NextLink string
+	// DeltaLink the link to resume a delta query from, only set on the last page of a delta query.
+	DeltaLink string
+	// Count the total number of items in the collection, when $count was requested.
+	Count           int64
+	Context         string
+	RequestID       string
+	ClientRequestID string
+	// Header the response's headers, e.g. Preference-Applied.
+	Header http.Header
+}
+
+// HasMore reports whether there is another page to fetch with NextLink.
+func (r *Response[T]) HasMore() bool {
+	return r.NextLink != ""
+}
+
+// odataCollection the envelope graph wraps every collection in.
+type odataCollection[T any] struct {
+	Context   string `json:"@odata.context,omitempty"`
+	NextLink  string `json:"@odata.nextLink,omitempty"`
+	DeltaLink string `json:"@odata.deltaLink,omitempty"`
+	Count     int64  `json:"@odata.count,omitempty"`
+	Value     []T    `json:"value,omitempty"`
+}
+
+func newResponse[T any](page *odataCollection[T], res *http.Response) *Response[T] {
+	return &Response[T]{
+		Items:           page.Value,
+		NextLink:        page.NextLink,
+		DeltaLink:       page.DeltaLink,
+		Count:           page.Count,
+		Context:         page.Context,
+		RequestID:       res.Header.Get("request-id"),
+		ClientRequestID: res.Header.Get("client-request-id"),
+		Header:          res.Header,
+	}
+}
+
+// GetResponse performs a get request for a collection at path with the given session, returning the page as a Response of T.
+// It allows collections which have no dedicated call to be read without defining an envelope struct, e.g.
+//
+//	children, err := outlook.GetResponse[*outlook.Folder](ctx, session, "/mailFolders/inbox/childFolders", nil)
+func GetResponse[T any](ctx context.Context, session *Session, path string, params map[string]interface{}, opts ...RequestOption) (*Response[T], error) {
+	var page odataCollection[T]
+	res, err := session.Get(ctx, path, params, &page, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newResponse(&page, res), nil
+}
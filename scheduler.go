@@ -0,0 +1,162 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MeetingRequest describes a meeting for a SchedulingAssistant to find a time for and create.
+type MeetingRequest struct {
+	Subject     string
+	Body        *MessageBody
+	Attendees   []*Attendee
+	Location    *Location
+	Duration    time.Duration
+	WindowStart time.Time
+	WindowEnd   time.Time
+}
+
+// SchedulingAssistant finds a time that suits every attendee of a meeting and creates the event at that time.
+type SchedulingAssistant struct {
+	session       *Session
+	calendarID    string
+	onlineMeeting bool
+	interval      time.Duration
+}
+
+// NewSchedulingAssistant returns a new instance of a SchedulingAssistant which creates online meetings in the primary calendar.
+func NewSchedulingAssistant(session *Session) *SchedulingAssistant {
+	return &SchedulingAssistant{
+		session:       session,
+		calendarID:    "primary",
+		onlineMeeting: true,
+		interval:      15 * time.Minute,
+	}
+}
+
+// CalendarID sets the calendar the meeting is created in.
+func (sa *SchedulingAssistant) CalendarID(calendarID string) *SchedulingAssistant {
+	sa.calendarID = calendarID
+	return sa
+}
+
+// OnlineMeeting sets whether the created meeting has an online meeting attached. Defaults to true.
+func (sa *SchedulingAssistant) OnlineMeeting(enabled bool) *SchedulingAssistant {
+	sa.onlineMeeting = enabled
+	return sa
+}
+
+// Interval sets the granularity used when falling back to free/busy lookups. Defaults to 15 minutes.
+func (sa *SchedulingAssistant) Interval(interval time.Duration) *SchedulingAssistant {
+	sa.interval = interval
+	return sa
+}
+
+// FindTime returns the best slot for the meeting, asking findMeetingTimes first and falling back to getSchedule when it has no suggestion.
+func (sa *SchedulingAssistant) FindTime(ctx context.Context, meeting *MeetingRequest) (*TimeSlot, error) {
+	if meeting.Duration <= 0 {
+		return nil, fmt.Errorf("meeting duration must be positive")
+	}
+	if !meeting.WindowEnd.After(meeting.WindowStart) {
+		return nil, fmt.Errorf("meeting window end must be after its start")
+	}
+
+	slot, err := sa.suggestTime(ctx, meeting)
+	if err != nil && ctx.Err() != nil {
+		return nil, err
+	}
+	if slot != nil {
+		return slot, nil
+	}
+
+	return sa.freeBusyTime(ctx, meeting)
+}
+
+// Schedule finds the best slot for the meeting and creates the event there, returning the created event.
+func (sa *SchedulingAssistant) Schedule(ctx context.Context, meeting *MeetingRequest) (*Event, error) {
+	slot, err := sa.FindTime(ctx, meeting)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		Subject:         meeting.Subject,
+		Body:            meeting.Body,
+		Attendees:       meeting.Attendees,
+		Location:        meeting.Location,
+		Start:           slot.Start,
+		End:             slot.End,
+		IsOnlineMeeting: sa.onlineMeeting,
+	}
+	if sa.onlineMeeting {
+		event.OnlineMeetingProvider = OnlineMeetingProviderTeamsForBusiness
+	}
+	return sa.session.Events().Create(sa.calendarID).Event(event).Do(ctx)
+}
+
+func (sa *SchedulingAssistant) suggestTime(ctx context.Context, meeting *MeetingRequest) (*TimeSlot, error) {
+	result, err := sa.session.Calendars().FindMeetingTimes().
+		Attendees(meeting.Attendees...).
+		MeetingDuration(meeting.Duration).
+		TimeConstraint(&TimeConstraint{
+			ActivityDomain: ActivityDomainWork,
+			TimeSlots: []*TimeSlot{{
+				Start: utcDateTimeTimeZone(meeting.WindowStart),
+				End:   utcDateTimeTimeZone(meeting.WindowEnd),
+			}},
+		}).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *MeetingTimeSuggestion
+	for _, suggestion := range result.MeetingTimeSuggestions {
+		if suggestion.MeetingTimeSlot == nil {
+			continue
+		}
+		if best == nil || suggestion.Order < best.Order {
+			best = suggestion
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	return best.MeetingTimeSlot, nil
+}
+
+func (sa *SchedulingAssistant) freeBusyTime(ctx context.Context, meeting *MeetingRequest) (*TimeSlot, error) {
+	var organizer User
+	if _, err := sa.session.Get(ctx, "", nil, &organizer); err != nil {
+		return nil, err
+	}
+
+	schedules := []string{organizer.Email}
+	for _, attendee := range meeting.Attendees {
+		if attendee.EmailAddress != nil && attendee.Type != AttendeeTypeOptional {
+			schedules = append(schedules, attendee.EmailAddress.Address)
+		}
+	}
+
+	result, err := sa.session.Calendars().GetSchedule(schedules, meeting.WindowStart, meeting.WindowEnd).
+		Interval(sa.interval).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := CommonFreeSlots(result.Value, meeting.WindowStart, sa.interval, meeting.Duration)
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 {
+		return nil, ErrNoMeetingTimes
+	}
+
+	start := slots[0].Start
+	return &TimeSlot{
+		Start: utcDateTimeTimeZone(start),
+		End:   utcDateTimeTimeZone(start.Add(meeting.Duration)),
+	}, nil
+}
@@ -0,0 +1,129 @@
+package outlook
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Scope a microsoft graph permission which may be requested from the user or tenant.
+type Scope string
+
+// Delegated permissions used by the services in this sdk.
+const (
+	ScopeOpenID                   Scope = "openid"
+	ScopeProfile                  Scope = "profile"
+	ScopeEmail                    Scope = "email"
+	ScopeOfflineAccess            Scope = "offline_access"
+	ScopeUserRead                 Scope = "User.Read"
+	ScopeUserReadBasicAll         Scope = "User.ReadBasic.All"
+	ScopeMailRead                 Scope = "Mail.Read"
+	ScopeMailReadBasic            Scope = "Mail.ReadBasic"
+	ScopeMailReadWrite            Scope = "Mail.ReadWrite"
+	ScopeMailReadShared           Scope = "Mail.Read.Shared"
+	ScopeMailReadWriteShared      Scope = "Mail.ReadWrite.Shared"
+	ScopeMailSend                 Scope = "Mail.Send"
+	ScopeMailSendShared           Scope = "Mail.Send.Shared"
+	ScopeMailboxSettingsRead      Scope = "MailboxSettings.Read"
+	ScopeMailboxSettingsReadWrite Scope = "MailboxSettings.ReadWrite"
+	ScopeCalendarsRead            Scope = "Calendars.Read"
+	ScopeCalendarsReadShared      Scope = "Calendars.Read.Shared"
+	ScopeCalendarsReadWrite       Scope = "Calendars.ReadWrite"
+	ScopeCalendarsReadWriteShared Scope = "Calendars.ReadWrite.Shared"
+	ScopePlaceReadAll             Scope = "Place.Read.All"
+	ScopeGroupReadAll             Scope = "Group.Read.All"
+	ScopeGroupReadWriteAll        Scope = "Group.ReadWrite.All"
+	ScopeReportsReadAll           Scope = "Reports.Read.All"
+	ScopeDefault                  Scope = DefaultAppScope
+)
+
+// DefaultScopes the scopes requested by the interactive authentication helpers when none are given, equivalent to DefaultAuthScopes.
+var DefaultScopes = NewScopeSet(ScopeMailRead, ScopeCalendarsRead, ScopeUserRead, ScopeOfflineAccess)
+
+// ScopeSet a de-duplicated set of scopes.
+type ScopeSet struct {
+	scopes map[Scope]struct{}
+}
+
+// NewScopeSet returns a new instance of a ScopeSet containing the given scopes.
+func NewScopeSet(scopes ...Scope) *ScopeSet {
+	ss := &ScopeSet{scopes: map[Scope]struct{}{}}
+	return ss.Add(scopes...)
+}
+
+// Add adds scopes to the set.
+func (ss *ScopeSet) Add(scopes ...Scope) *ScopeSet {
+	for _, scope := range scopes {
+		ss.scopes[scope] = struct{}{}
+	}
+	return ss
+}
+
+// Remove removes scopes from the set.
+func (ss *ScopeSet) Remove(scopes ...Scope) *ScopeSet {
+	for _, scope := range scopes {
+		delete(ss.scopes, scope)
+	}
+	return ss
+}
+
+// Contains reports whether scope is in the set. Scopes are compared case insensitively, as microsoft does.
+func (ss *ScopeSet) Contains(scope Scope) bool {
+	for candidate := range ss.scopes {
+		if strings.EqualFold(string(candidate), string(scope)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Strings returns the scopes in the set, sorted, in the form expected by oauth2.Config.
+func (ss *ScopeSet) Strings() []string {
+	scopes := make([]string, 0, len(ss.scopes))
+	for scope := range ss.scopes {
+		scopes = append(scopes, string(scope))
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+// String returns the scopes in the set as a space separated list, the form used on the wire.
+func (ss *ScopeSet) String() string {
+	return strings.Join(ss.Strings(), " ")
+}
+
+// AdminConsentURL returns the url a tenant administrator visits to grant the application's permissions for the whole tenant.
+// When no scopes are given, every permission configured on the app registration is requested.
+func (a Authority) AdminConsentURL(clientID, redirectURL, state string, scopes ...Scope) string {
+	scope := NewScopeSet(scopes...)
+	if len(scopes) == 0 {
+		scope.Add(ScopeDefault)
+	}
+	query := url.Values{
+		"client_id":    {clientID},
+		"redirect_uri": {redirectURL},
+		"scope":        {qualifyScopes(scope.Strings(), a.GraphResource())},
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	return a.String() + "/v2.0/adminconsent?" + query.Encode()
+}
+
+// qualifyScopes prefixes graph permission names with the graph resource, as required by the admin consent endpoint. Scopes
+// qualified with the global graph resource, such as ScopeDefault, are moved to resource, the graph of the authority's cloud.
+func qualifyScopes(scopes []string, resource string) string {
+	qualified := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		switch {
+		case strings.HasPrefix(scope, DefaultGraphResource+"/"):
+			scope = resource + strings.TrimPrefix(scope, DefaultGraphResource)
+		case strings.Contains(scope, "://"):
+		case scope == string(ScopeOpenID), scope == string(ScopeProfile), scope == string(ScopeEmail), scope == string(ScopeOfflineAccess):
+		default:
+			scope = resource + "/" + scope
+		}
+		qualified = append(qualified, scope)
+	}
+	return strings.Join(qualified, " ")
+}
@@ -0,0 +1,193 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+)
+
+// SearchFolderODataType the @odata.type graph requires on search folders.
+const SearchFolderODataType = "microsoft.graph.mailSearchFolder"
+
+// SearchFolderService manages communication with microsofts graph for mailSearchFolder resources, persistent server side
+// views of the messages matching a filter across a set of source folders.
+type SearchFolderService struct {
+	session  *Session
+	basePath string
+}
+
+// NewSearchFolderService returns a new instance of a SearchFolderService.
+func NewSearchFolderService(session *Session) *SearchFolderService {
+	return &SearchFolderService{
+		session:  session,
+		basePath: "/mailFolders",
+	}
+}
+
+// SearchFolderListCall struct allowing for fluent style configuration of calls listing the search folders under a folder.
+type SearchFolderListCall struct {
+	service  *SearchFolderService
+	parentID string
+}
+
+// List returns a SearchFolderListCall listing the search folders under the well-known searchfolders folder.
+func (sfs *SearchFolderService) List() *SearchFolderListCall {
+	return &SearchFolderListCall{
+		service:  sfs,
+		parentID: FolderSearchFolders,
+	}
+}
+
+// Parent lists the search folders under the given folder instead.
+func (sflc *SearchFolderListCall) Parent(parentID string) *SearchFolderListCall {
+	sflc.parentID = parentID
+	return sflc
+}
+
+// Do executes the search folder list call, returning the search folder list result.
+func (sflc *SearchFolderListCall) Do(ctx context.Context, opts ...RequestOption) (*SearchFolderListResult, error) {
+	path := fmt.Sprintf("%s/%s/childFolders", sflc.service.basePath, sflc.parentID)
+	var result SearchFolderListResult
+	if _, err := sflc.service.session.Get(ctx, path, nil, &result, opts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchFolderGetCall struct allowing for fluent style configuration of calls to the mailSearchFolder get endpoint.
+type SearchFolderGetCall struct {
+	service  *SearchFolderService
+	folderID string
+}
+
+// Get returns an instance of a SearchFolderGetCall with the given folderID.
+func (sfs *SearchFolderService) Get(folderID string) *SearchFolderGetCall {
+	return &SearchFolderGetCall{
+		service:  sfs,
+		folderID: folderID,
+	}
+}
+
+// Do executes the http get request to microsoft's graph api to get the call's search folder.
+func (sfgc *SearchFolderGetCall) Do(ctx context.Context, opts ...RequestOption) (*SearchFolder, error) {
+	path := fmt.Sprintf("%s/%s", sfgc.service.basePath, sfgc.folderID)
+	folder := SearchFolder{}
+	if _, err := sfgc.service.session.Get(ctx, path, nil, &folder, opts...); err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// SearchFolderCreateCall struct allowing for fluent style configuration of calls to the mailSearchFolder create endpoint.
+type SearchFolderCreateCall struct {
+	service  *SearchFolderService
+	parentID string
+	folder   *SearchFolder
+}
+
+// Create returns an instance of a SearchFolderCreateCall creating a search folder, under the well-known searchfolders folder,
+// showing the messages of the source folders matching filterQuery, e.g. "isRead eq false".
+func (sfs *SearchFolderService) Create(displayName, filterQuery string, sourceFolderIDs ...string) *SearchFolderCreateCall {
+	return &SearchFolderCreateCall{
+		service:  sfs,
+		parentID: FolderSearchFolders,
+		folder: &SearchFolder{
+			ODataType:       SearchFolderODataType,
+			Folder:          Folder{DisplayName: displayName},
+			FilterQuery:     filterQuery,
+			SourceFolderIDs: sourceFolderIDs,
+		},
+	}
+}
+
+// Parent creates the search folder under the given folder instead.
+func (sfcc *SearchFolderCreateCall) Parent(parentID string) *SearchFolderCreateCall {
+	sfcc.parentID = parentID
+	return sfcc
+}
+
+// IncludeNestedFolders sets whether the source folders' children are searched too.
+func (sfcc *SearchFolderCreateCall) IncludeNestedFolders(include bool) *SearchFolderCreateCall {
+	sfcc.folder.IncludeNestedFolders = &include
+	return sfcc
+}
+
+// Do executes the http post request to microsoft's graph api to create the call's search folder.
+func (sfcc *SearchFolderCreateCall) Do(ctx context.Context, opts ...RequestOption) (*SearchFolder, error) {
+	path := fmt.Sprintf("%s/%s/childFolders", sfcc.service.basePath, sfcc.parentID)
+	if _, err := sfcc.service.session.Post(ctx, path, sfcc.folder, sfcc.folder, opts...); err != nil {
+		return nil, err
+	}
+	return sfcc.folder, nil
+}
+
+// SearchFolderUpdateCall struct allowing for fluent style configuration of calls to the mailSearchFolder update endpoint.
+type SearchFolderUpdateCall struct {
+	service  *SearchFolderService
+	folderID string
+	folder   *SearchFolder
+}
+
+// Update returns an instance of a SearchFolderUpdateCall for the given search folder. Only the properties set on the call are updated.
+func (sfs *SearchFolderService) Update(folderID string) *SearchFolderUpdateCall {
+	return &SearchFolderUpdateCall{
+		service:  sfs,
+		folderID: folderID,
+		folder:   &SearchFolder{ODataType: SearchFolderODataType},
+	}
+}
+
+// DisplayName renames the search folder.
+func (sfuc *SearchFolderUpdateCall) DisplayName(displayName string) *SearchFolderUpdateCall {
+	sfuc.folder.DisplayName = displayName
+	return sfuc
+}
+
+// FilterQuery replaces the search folder's filter.
+func (sfuc *SearchFolderUpdateCall) FilterQuery(filterQuery string) *SearchFolderUpdateCall {
+	sfuc.folder.FilterQuery = filterQuery
+	return sfuc
+}
+
+// SourceFolders replaces the folders the search folder searches.
+func (sfuc *SearchFolderUpdateCall) SourceFolders(sourceFolderIDs ...string) *SearchFolderUpdateCall {
+	sfuc.folder.SourceFolderIDs = sourceFolderIDs
+	return sfuc
+}
+
+// IncludeNestedFolders sets whether the source folders' children are searched too.
+func (sfuc *SearchFolderUpdateCall) IncludeNestedFolders(include bool) *SearchFolderUpdateCall {
+	sfuc.folder.IncludeNestedFolders = &include
+	return sfuc
+}
+
+// Do executes the http patch request to microsoft's graph api to update the call's search folder.
+func (sfuc *SearchFolderUpdateCall) Do(ctx context.Context, opts ...RequestOption) (*SearchFolder, error) {
+	path := fmt.Sprintf("%s/%s", sfuc.service.basePath, sfuc.folderID)
+	if _, err := sfuc.service.session.Patch(ctx, path, sfuc.folder, sfuc.folder, opts...); err != nil {
+		return nil, err
+	}
+	return sfuc.folder, nil
+}
+
+// SearchFolderDeleteCall struct allowing for fluent style configuration of calls to the mailSearchFolder delete endpoint.
+type SearchFolderDeleteCall struct {
+	service  *SearchFolderService
+	folderID string
+}
+
+// Delete returns an instance of a SearchFolderDeleteCall deleting the given search folder. The messages it shows are not affected.
+func (sfs *SearchFolderService) Delete(folderID string) *SearchFolderDeleteCall {
+	return &SearchFolderDeleteCall{
+		service:  sfs,
+		folderID: folderID,
+	}
+}
+
+// Do executes the http delete request to microsoft's graph api to delete the call's search folder.
+func (sfdc *SearchFolderDeleteCall) Do(ctx context.Context, opts ...RequestOption) error {
+	path := fmt.Sprintf("%s/%s", sfdc.service.basePath, sfdc.folderID)
+	if _, err := sfdc.service.session.Delete(ctx, path, nil, nil, opts...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -6,14 +6,20 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
 )
 
 // Session manages communication to microsoft's graph api as an authenticated user.
 type Session struct {
-	client       *Client
-	basePath     string
-	accessToken  string
-	refreshToken string
+	client      *Client
+	basePath    string
+	apiVersion  string
+	tokenSource oauth2.TokenSource
+	dryRun      bool
 }
 
 // NewSession returns a new instance of a Session.
@@ -22,28 +28,82 @@ func NewSession(client *Client) (*Session, error) {
 		return nil, fmt.Errorf("no token source provided")
 	}
 
-	token, err := client.tokenSource.Token()
-	if err != nil {
+	return NewSessionWithTokenSource(client, client.tokenSource)
+}
+
+// NewSessionWithTokenSource returns a new instance of a Session which authenticates with tokenSource rather than the client's.
+// This allows one client, and its underlying connections, to be shared by sessions for many accounts.
+func NewSessionWithTokenSource(client *Client, tokenSource oauth2.TokenSource) (*Session, error) {
+	if tokenSource == nil {
+		return nil, fmt.Errorf("no token source provided")
+	}
+
+	// Tokens are cached and only refreshed once expired, so long lived sessions keep working past the first token's lifetime.
+	tokenSource = NewReuseTokenSource(nil, tokenSource)
+	if _, err := tokenSource.Token(); err != nil {
 		return nil, err
 	}
 
 	session := &Session{
-		client:       client,
-		basePath:     "/me",
-		accessToken:  token.AccessToken,
-		refreshToken: token.RefreshToken,
+		client:      client,
+		basePath:    "/me",
+		tokenSource: tokenSource,
 	}
 
 	return session, nil
 }
 
-func (session *Session) query(ctx context.Context, method, urlPath string, params map[string]interface{}, data interface{}, result interface{}) (*http.Response, error) {
+// ForUser returns a copy of the session which operates on the mailbox of the given user id or userPrincipalName rather than the signed in user.
+// The session's credentials must have been granted access to that mailbox, either through delegation, a shared mailbox, or application permissions.
+func (session *Session) ForUser(idOrUPN string) *Session {
+	return session.withBasePath(fmt.Sprintf("/users/%s", url.PathEscape(idOrUPN)))
+}
+
+// ForGroup returns a copy of the session which operates on the calendar, events, and conversations of the microsoft 365 group
+// with the given id, e.g. session.ForGroup(id).Events().List("primary"). Groups have a single calendar, addressed as "primary".
+// Graph only allows delegated access to group calendars, so the session must act for a signed in member of the group.
+func (session *Session) ForGroup(groupID string) *Session {
+	return session.withBasePath(fmt.Sprintf("/groups/%s", url.PathEscape(groupID)))
+}
+
+// WithAPIVersion returns a copy of the session whose requests are made against the given graph api version, e.g. APIVersionBeta,
+// regardless of the version its client is configured for.
+func (session *Session) WithAPIVersion(version string) *Session {
+	clone := *session
+	clone.apiVersion = version
+	return &clone
+}
+
+// usesBeta reports whether the session's requests are made against the beta api, either through WithAPIVersion or its client's base url.
+func (session *Session) usesBeta() bool {
+	if session.apiVersion != "" {
+		return session.apiVersion == APIVersionBeta
+	}
+	return path.Base(strings.TrimSuffix(session.client.baseURL.Path, "/")) == APIVersionBeta
+}
+
+// withBasePath returns a copy of the session which resolves request paths relative to basePath rather than the signed in user.
+func (session *Session) withBasePath(basePath string) *Session {
+	clone := *session
+	clone.basePath = basePath
+	return &clone
+}
+
+func (session *Session) query(ctx context.Context, method, urlPath string, params map[string]interface{}, header http.Header, data interface{}, result interface{}, opts ...RequestOption) (*http.Response, error) {
+	ctx, cancel, params, header := newRequestOptions(opts).apply(ctx, params, header)
+	defer cancel()
+
 	var queryString string
 	if params != nil {
 		queryString = createQueryString(params)
 	}
 
-	parsedBasePath, err := url.Parse(session.basePath)
+	basePath := session.basePath
+	if basePath == "" {
+		basePath = "/"
+	}
+
+	parsedBasePath, err := url.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
@@ -53,39 +113,64 @@ func (session *Session) query(ctx context.Context, method, urlPath string, param
 		path.RawQuery = queryString
 	}
 
-	req, err := session.client.NewRequest(ctx, method, path.String(), data)
+	requestPath := path.String()
+	if session.apiVersion != "" {
+		requestPath = session.client.versionedBaseURL(session.apiVersion).String() + requestPath
+	}
+
+	req, err := session.client.NewRequest(ctx, method, requestPath, data)
 	if err != nil {
 		return nil, err
 	}
 
-	if session.accessToken == "" {
+	token, err := TokenWithContext(ctx, session.tokenSource)
+	if err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
 		return nil, ErrNoAccessToken
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", session.accessToken))
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	token.SetAuthHeader(req)
+
+	if session.dryRun {
+		ctx = context.WithValue(ctx, dryRunKey{}, true)
+	}
+
+	queueStart := time.Now()
+	release, err := session.client.mailboxLimiter.acquire(ctx, session.mailboxKey())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	ctx = context.WithValue(ctx, queueWaitKey{}, time.Since(queueStart))
 
-	// May want to detect failures due to invalid or expired tokens, then retry after attempting to refresh the token
 	return session.client.Do(ctx, req, result)
 }
 
 // Get performs a get request to microsofts api with the underlying client and the sessions accessToken for authorization.
-func (session *Session) Get(ctx context.Context, url string, params map[string]interface{}, result interface{}) (*http.Response, error) {
-	return session.query(ctx, http.MethodGet, url, params, nil, result)
+func (session *Session) Get(ctx context.Context, url string, params map[string]interface{}, result interface{}, opts ...RequestOption) (*http.Response, error) {
+	return session.query(ctx, http.MethodGet, url, params, nil, nil, result, opts...)
 }
 
 // Post performs a post request to microsofts api with the underlying client and the sessions accessToken for authorization.
-func (session *Session) Post(ctx context.Context, url string, data interface{}, result interface{}) (*http.Response, error) {
-	return session.query(ctx, http.MethodPost, url, nil, data, result)
+func (session *Session) Post(ctx context.Context, url string, data interface{}, result interface{}, opts ...RequestOption) (*http.Response, error) {
+	return session.query(ctx, http.MethodPost, url, nil, nil, data, result, opts...)
 }
 
 // Patch performs a patch request to microsofts api with the underlying client and the sessions accessToken for authorization.
-func (session *Session) Patch(ctx context.Context, url string, data interface{}, result interface{}) (*http.Response, error) {
-	return session.query(ctx, http.MethodPatch, url, nil, data, result)
+func (session *Session) Patch(ctx context.Context, url string, data interface{}, result interface{}, opts ...RequestOption) (*http.Response, error) {
+	return session.query(ctx, http.MethodPatch, url, nil, nil, data, result, opts...)
 }
 
 // Delete performs a delete request to microsofts api with the underlying client and the sessions accessToken for authorization.
-func (session *Session) Delete(ctx context.Context, url string, params map[string]interface{}, result interface{}) (*http.Response, error) {
-	return session.query(ctx, http.MethodDelete, url, params, nil, result)
+func (session *Session) Delete(ctx context.Context, url string, params map[string]interface{}, result interface{}, opts ...RequestOption) (*http.Response, error) {
+	return session.query(ctx, http.MethodDelete, url, params, nil, nil, result, opts...)
 }
 
 // Calendars returns an instance of a CalendarService using this session.
@@ -93,6 +178,16 @@ func (session *Session) Calendars() *CalendarService {
 	return NewCalendarService(session)
 }
 
+// CalendarGroups returns an instance of a CalendarGroupService using this session.
+func (session *Session) CalendarGroups() *CalendarGroupService {
+	return NewCalendarGroupService(session)
+}
+
+// Conversations returns an instance of a ConversationService using this session, which must be a group session, see ForGroup.
+func (session *Session) Conversations() *ConversationService {
+	return NewConversationService(session)
+}
+
 // Events returns an instance of a EventService using this session.
 func (session *Session) Events() *EventService {
 	return NewEventService(session)
@@ -103,21 +198,89 @@ func (session *Session) Folders() *FolderService {
 	return NewFolderService(session)
 }
 
+// InferenceClassificationOverrides returns an instance of an InferenceClassificationOverrideService using this session.
+func (session *Session) InferenceClassificationOverrides() *InferenceClassificationOverrideService {
+	return NewInferenceClassificationOverrideService(session)
+}
+
+// Insights returns an instance of an InsightsService using this session.
+func (session *Session) Insights() *InsightsService {
+	return NewInsightsService(session)
+}
+
 // Messages returns an instance of a MessageService using this session.
 func (session *Session) Messages() *MessageService {
 	return NewMessageService(session)
 }
 
-func (s *Session) Send(ctx context.Context, message *Message) error {
+// Places returns an instance of a PlaceService using this session.
+func (session *Session) Places() *PlaceService {
+	return NewPlaceService(session)
+}
+
+// Reminders returns an instance of a ReminderService using this session.
+func (session *Session) Reminders() *ReminderService {
+	return NewReminderService(session)
+}
+
+// Reports returns an instance of a ReportsService using this session.
+func (session *Session) Reports() *ReportsService {
+	return NewReportsService(session)
+}
+
+// SearchFolders returns an instance of a SearchFolderService using this session.
+func (session *Session) SearchFolders() *SearchFolderService {
+	return NewSearchFolderService(session)
+}
+
+// Send sends the message from the session's mailbox, saving a copy to Sent Items. Use SendMail to configure the send.
+func (s *Session) Send(ctx context.Context, message *Message, opts ...RequestOption) error {
+	return s.SendMail(message).Do(ctx, opts...)
+}
+
+// SendMailCall struct allowing for fluent style configuration of calls to the sendMail endpoint.
+type SendMailCall struct {
+	session         *Session
+	message         *Message
+	saveToSentItems *bool
+}
+
+// SendMail returns an instance of a SendMailCall sending message from the session's mailbox.
+//
+// To send on behalf of another mailbox, set the message's From to that mailbox and send from the signed in user's session;
+// the signed in user is recorded as the Sender. To send as a shared mailbox, send from session.ForUser(sharedMailbox) instead.
+func (s *Session) SendMail(message *Message) *SendMailCall {
+	return &SendMailCall{
+		session: s,
+		message: message,
+	}
+}
+
+// SaveToSentItems sets whether a copy of the message is saved to the Sent Items folder, which graph does by default.
+// High volume automated senders should turn it off to keep from flooding the folder.
+func (smc *SendMailCall) SaveToSentItems(save bool) *SendMailCall {
+	smc.saveToSentItems = &save
+	return smc
+}
+
+// Do executes the send. ErrSendAsDenied is returned, wrapping the underlying status error, when the caller lacks the
+// required Send As or Send on Behalf rights.
+func (smc *SendMailCall) Do(ctx context.Context, opts ...RequestOption) error {
 	endpoint := "/sendMail"
 
 	body := map[string]interface{}{
-		"message": message,
+		"message": smc.message,
+	}
+	if smc.saveToSentItems != nil {
+		body["saveToSentItems"] = *smc.saveToSentItems
 	}
 
 	// This method does not return any body, so we need to check for errors in the response
-	resp, err := s.query(ctx, http.MethodPost, endpoint, nil, body, nil)
+	resp, err := smc.session.query(ctx, http.MethodPost, endpoint, nil, nil, body, nil, opts...)
 	if err != nil {
+		if isSendAsDenied(err) {
+			return fmt.Errorf("%w: %w", ErrSendAsDenied, err)
+		}
 		return err
 	}
 
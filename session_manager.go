@@ -0,0 +1,162 @@
+package outlook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SessionFactory creates the session for an account the first time it is requested from a SessionManager,
+// typically by loading the account's stored refresh token and calling NewSessionWithTokenSource.
+type SessionFactory func(ctx context.Context, accountID string) (*Session, error)
+
+// SessionManagerOpt functions to configure options on a SessionManager.
+type SessionManagerOpt func(*SessionManager)
+
+// SetSessionManagerIdleTimeout returns a SessionManagerOpt which evicts sessions that have not been requested for the given duration.
+func SetSessionManagerIdleTimeout(timeout time.Duration) SessionManagerOpt {
+	return func(sm *SessionManager) {
+		sm.idleTimeout = timeout
+	}
+}
+
+// SetSessionManagerMaxSessions returns a SessionManagerOpt which caps the number of sessions held, evicting the least recently used first.
+func SetSessionManagerMaxSessions(max int) SessionManagerOpt {
+	return func(sm *SessionManager) {
+		sm.maxSessions = max
+	}
+}
+
+type managedSession struct {
+	session  *Session
+	lastUsed time.Time
+}
+
+// SessionManager holds sessions for many accounts keyed by account id, creating them on demand with a SessionFactory.
+// Each session refreshes its own tokens, so accounts never share credentials.
+type SessionManager struct {
+	factory     SessionFactory
+	idleTimeout time.Duration
+	maxSessions int
+
+	mu       sync.Mutex
+	sessions map[string]*managedSession
+	// creating the factory calls in flight, each channel closed once its call returns.
+	creating map[string]chan struct{}
+}
+
+// NewSessionManager returns a new instance of a SessionManager with the given options set.
+func NewSessionManager(factory SessionFactory, opts ...SessionManagerOpt) *SessionManager {
+	sm := &SessionManager{
+		factory:  factory,
+		sessions: map[string]*managedSession{},
+		creating: map[string]chan struct{}{},
+	}
+	for _, opt := range opts {
+		opt(sm)
+	}
+	return sm
+}
+
+// Get returns the session for accountID, creating it with the manager's SessionFactory if it is not already held.
+// Concurrent calls for the same account share a single factory call; callers waiting on another's call give up when their
+// context is done.
+func (sm *SessionManager) Get(ctx context.Context, accountID string) (*Session, error) {
+	for {
+		sm.mu.Lock()
+		sm.evictExpiredLocked()
+		if managed, ok := sm.sessions[accountID]; ok {
+			managed.lastUsed = time.Now()
+			sm.mu.Unlock()
+			return managed.session, nil
+		}
+		if pending, ok := sm.creating[accountID]; ok {
+			sm.mu.Unlock()
+			select {
+			case <-pending:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			// The creating call may have failed, in which case this call retries the factory itself.
+			continue
+		}
+
+		pending := make(chan struct{})
+		sm.creating[accountID] = pending
+		sm.mu.Unlock()
+
+		session, err := sm.factory(ctx, accountID)
+
+		sm.mu.Lock()
+		delete(sm.creating, accountID)
+		close(pending)
+		if err != nil {
+			sm.mu.Unlock()
+			return nil, err
+		}
+		sm.storeLocked(accountID, session)
+		sm.mu.Unlock()
+		return session, nil
+	}
+}
+
+// Add stores session for accountID, replacing any session already held.
+func (sm *SessionManager) Add(accountID string, session *Session) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.storeLocked(accountID, session)
+}
+
+// Remove evicts the session for accountID, e.g. after the account revokes consent or its refresh token is rejected.
+func (sm *SessionManager) Remove(accountID string) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	delete(sm.sessions, accountID)
+}
+
+// Len returns the number of sessions currently held.
+func (sm *SessionManager) Len() int {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return len(sm.sessions)
+}
+
+// EvictIdle removes every session idle for longer than the manager's idle timeout, returning how many were removed.
+func (sm *SessionManager) EvictIdle() int {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return sm.evictExpiredLocked()
+}
+
+func (sm *SessionManager) storeLocked(accountID string, session *Session) {
+	sm.sessions[accountID] = &managedSession{session: session, lastUsed: time.Now()}
+	if sm.maxSessions <= 0 {
+		return
+	}
+	for len(sm.sessions) > sm.maxSessions {
+		var oldestID string
+		var oldest time.Time
+		for id, managed := range sm.sessions {
+			if oldestID == "" || managed.lastUsed.Before(oldest) {
+				oldestID = id
+				oldest = managed.lastUsed
+			}
+		}
+		delete(sm.sessions, oldestID)
+	}
+}
+
+func (sm *SessionManager) evictExpiredLocked() int {
+	if sm.idleTimeout <= 0 {
+		return 0
+	}
+	evicted := 0
+	cutoff := time.Now().Add(-sm.idleTimeout)
+	for id, managed := range sm.sessions {
+		if managed.lastUsed.Before(cutoff) {
+			delete(sm.sessions, id)
+			evicted++
+		}
+	}
+	return evicted
+}
@@ -0,0 +1,334 @@
+package outlook
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+// S/MIME (RFC 8551) content types. Exchange keeps S/MIME messages as they were received, so their content is only
+// readable through the MIME endpoints, see MessageService.GetMIME; graph's json shows them as a single smime.p7m attachment.
+const (
+	SMIMEContentTypeSigned    = "multipart/signed"
+	SMIMEContentTypePKCS7     = "application/pkcs7-mime"
+	SMIMEContentTypeSignature = "application/pkcs7-signature"
+	// SMIMEAttachmentName the name of the attachment graph shows an S/MIME message's content as.
+	SMIMEAttachmentName = "smime.p7m"
+)
+
+// SMIMEKind how an S/MIME message's content is protected.
+type SMIMEKind string
+
+// SMIMEKind enum
+const (
+	// SMIMEKindNone the message is not S/MIME.
+	SMIMEKindNone SMIMEKind = ""
+	// SMIMEKindSigned a clear signed multipart/signed message, readable without verifying the signature.
+	SMIMEKindSigned SMIMEKind = "signed"
+	// SMIMEKindOpaqueSigned an application/pkcs7-mime signed-data message, whose content is inside the signature.
+	SMIMEKindOpaqueSigned SMIMEKind = "signed-data"
+	// SMIMEKindEnveloped an application/pkcs7-mime enveloped-data, or authEnveloped-data, encrypted message.
+	SMIMEKindEnveloped SMIMEKind = "enveloped-data"
+)
+
+// SMIMEVerifier verifies PKCS #7 signatures, as implemented by the caller with a CMS library and their trusted roots.
+type SMIMEVerifier interface {
+	// Verify verifies signedData, a DER encoded PKCS #7 SignedData, returning the certificates of its signers and the signed
+	// content. For detached signatures content is the signed content, and is returned as is; for opaque signatures it is nil
+	// and the content is read from signedData.
+	Verify(signedData, content []byte) (signed []byte, signers []*x509.Certificate, err error)
+}
+
+// SMIMEDecrypter decrypts PKCS #7 enveloped data, as implemented by the caller with a CMS library and the recipient's
+// certificate and private key.
+type SMIMEDecrypter interface {
+	// Decrypt decrypts envelopedData, a DER encoded PKCS #7 EnvelopedData or AuthEnvelopedData, returning its content.
+	Decrypt(envelopedData []byte) ([]byte, error)
+}
+
+// SMIMESigner signs content with the sender's certificate and private key, as implemented by the caller with a CMS library.
+type SMIMESigner interface {
+	// Sign returns a DER encoded, detached PKCS #7 SignedData signature of content.
+	Sign(content []byte) ([]byte, error)
+	// MICAlg the RFC 8551 name of the signature's digest algorithm, e.g. "sha-256".
+	MICAlg() string
+}
+
+// SMIMEEncrypter encrypts content for the message's recipients, as implemented by the caller with a CMS library and the
+// recipients' certificates.
+type SMIMEEncrypter interface {
+	// Encrypt returns a DER encoded PKCS #7 EnvelopedData of content.
+	Encrypt(content []byte) ([]byte, error)
+}
+
+// SMIMEResult the content of an S/MIME message, unwrapped by OpenSMIME.
+type SMIMEResult struct {
+	// Content the innermost MIME entity, its content headers followed by its body, which can be read with net/mail.
+	Content []byte
+	// Signers the certificates of the signers of the content, if it was signed.
+	Signers []*x509.Certificate
+	// Signed whether the content was signed, and its signature verified.
+	Signed bool
+	// Encrypted whether the content was encrypted.
+	Encrypted bool
+}
+
+// IsSMIME reports whether the message, as read through graph's json, is a signed or encrypted S/MIME message, whose content
+// must be read with MessageService.GetMIME and opened with OpenSMIME. Attachments must have been read.
+func (m *Message) IsSMIME() bool {
+	for _, attachment := range m.Attachments {
+		mediaType, _, _ := mime.ParseMediaType(attachment.ContentType)
+		switch {
+		case strings.EqualFold(mediaType, SMIMEContentTypeSigned), strings.EqualFold(mediaType, SMIMEContentTypePKCS7):
+			return true
+		case strings.EqualFold(attachment.Name, SMIMEAttachmentName):
+			return true
+		}
+	}
+	return false
+}
+
+// DetectSMIME returns how the RFC 5322 MIME entity is protected, from its top level Content-Type. application/pkcs7-mime
+// entities are told apart by their smime-type; those of another type, e.g. certs-only or compressed-data, or without one are
+// reported as SMIMEKindNone.
+func DetectSMIME(entity []byte) SMIMEKind {
+	header, _ := splitEntity(entity)
+	mediaType, params, err := mime.ParseMediaType(headerValue(header, "Content-Type"))
+	if err != nil {
+		return SMIMEKindNone
+	}
+	switch strings.ToLower(mediaType) {
+	case SMIMEContentTypeSigned:
+		return SMIMEKindSigned
+	case SMIMEContentTypePKCS7, "application/x-pkcs7-mime":
+		switch strings.ToLower(params["smime-type"]) {
+		case "signed-data":
+			return SMIMEKindOpaqueSigned
+		case "enveloped-data", "authenveloped-data":
+			return SMIMEKindEnveloped
+		}
+	}
+	return SMIMEKindNone
+}
+
+// OpenSMIME unwraps an S/MIME message, e.g. as read by MessageService.GetMIME, verifying its signatures with verifier and
+// decrypting it with decrypter, through as many layers as it was signed and encrypted with. Either may be nil when the message
+// is known not to need it; a message which does fails. Messages which are not S/MIME are returned as they are.
+func OpenSMIME(message []byte, verifier SMIMEVerifier, decrypter SMIMEDecrypter) (*SMIMEResult, error) {
+	result := &SMIMEResult{Content: message}
+	// Messages are rarely wrapped more than twice, signed then encrypted; the limit keeps a malicious message from looping.
+	for depth := 0; depth < 8; depth++ {
+		header, body := splitEntity(result.Content)
+		kind := DetectSMIME(result.Content)
+		switch kind {
+		case SMIMEKindNone:
+			return result, nil
+		case SMIMEKindSigned:
+			if verifier == nil {
+				return nil, fmt.Errorf("smime: message is signed but no verifier was given")
+			}
+			_, params, _ := mime.ParseMediaType(headerValue(header, "Content-Type"))
+			content, signature, err := splitSigned(body, params["boundary"])
+			if err != nil {
+				return nil, err
+			}
+			_, signers, err := verifier.Verify(signature, content)
+			if err != nil {
+				return nil, fmt.Errorf("smime: %w", err)
+			}
+			result.Content, result.Signers, result.Signed = content, signers, true
+		case SMIMEKindOpaqueSigned:
+			if verifier == nil {
+				return nil, fmt.Errorf("smime: message is signed but no verifier was given")
+			}
+			signedData, err := decodeEntityBody(header, body)
+			if err != nil {
+				return nil, err
+			}
+			content, signers, err := verifier.Verify(signedData, nil)
+			if err != nil {
+				return nil, fmt.Errorf("smime: %w", err)
+			}
+			result.Content, result.Signers, result.Signed = content, signers, true
+		case SMIMEKindEnveloped:
+			if decrypter == nil {
+				return nil, fmt.Errorf("smime: message is encrypted but no decrypter was given")
+			}
+			envelopedData, err := decodeEntityBody(header, body)
+			if err != nil {
+				return nil, err
+			}
+			content, err := decrypter.Decrypt(envelopedData)
+			if err != nil {
+				return nil, fmt.Errorf("smime: %w", err)
+			}
+			result.Content, result.Encrypted = content, true
+		}
+	}
+	return nil, fmt.Errorf("smime: message is nested too deeply")
+}
+
+// SignMIME clear signs an RFC 5322 MIME message, e.g. as written by WriteMIME, returning a multipart/signed message which
+// can be sent with Session.SendMIME or saved with Session.CreateDraftMIME. The message's content headers and body are signed;
+// its other headers, such as From, To, and Subject, stay on the outer message.
+func SignMIME(message []byte, signer SMIMESigner) ([]byte, error) {
+	outer, content := splitMessageContent(message)
+	signature, err := signer.Sign(content)
+	if err != nil {
+		return nil, fmt.Errorf("smime: %w", err)
+	}
+
+	boundary, err := smimeBoundary()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Write(outer)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: %s\r\n\r\n", mime.FormatMediaType(SMIMEContentTypeSigned, map[string]string{
+		"protocol": SMIMEContentTypeSignature,
+		"micalg":   signer.MICAlg(),
+		"boundary": boundary,
+	}))
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	buf.Write(content)
+	fmt.Fprintf(&buf, "\r\n--%s\r\n", boundary)
+	fmt.Fprintf(&buf, "Content-Type: %s\r\n", mime.FormatMediaType(SMIMEContentTypeSignature, map[string]string{"name": "smime.p7s"}))
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("Content-Disposition: attachment; filename=\"smime.p7s\"\r\n\r\n")
+	if err := writeBase64Lines(&buf, signature); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(&buf, "\r\n--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+// EncryptMIME encrypts an RFC 5322 MIME message, e.g. as written by WriteMIME or SignMIME, returning an application/pkcs7-mime
+// message which can be sent with Session.SendMIME. As with SignMIME, only the content headers and body are encrypted.
+func EncryptMIME(message []byte, encrypter SMIMEEncrypter) ([]byte, error) {
+	outer, content := splitMessageContent(message)
+	envelopedData, err := encrypter.Encrypt(content)
+	if err != nil {
+		return nil, fmt.Errorf("smime: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(outer)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: %s\r\n", mime.FormatMediaType(SMIMEContentTypePKCS7, map[string]string{
+		"smime-type": "enveloped-data",
+		"name":       SMIMEAttachmentName,
+	}))
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	fmt.Fprintf(&buf, "Content-Disposition: attachment; filename=%q\r\n\r\n", SMIMEAttachmentName)
+	if err := writeBase64Lines(&buf, envelopedData); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// splitEntity splits a MIME entity into its raw header, unfolded into one field per line, and its body.
+func splitEntity(entity []byte) ([]string, []byte) {
+	head, body, ok := bytes.Cut(entity, []byte("\r\n\r\n"))
+	if !ok {
+		head, body, _ = bytes.Cut(entity, []byte("\n\n"))
+	}
+	var fields []string
+	for _, line := range strings.Split(strings.ReplaceAll(string(head), "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(fields) > 0 {
+			fields[len(fields)-1] += " " + strings.TrimSpace(line)
+			continue
+		}
+		fields = append(fields, line)
+	}
+	return fields, body
+}
+
+// headerValue returns the value of the first field of header with the given name.
+func headerValue(header []string, name string) string {
+	for _, field := range header {
+		if key, value, ok := strings.Cut(field, ":"); ok && strings.EqualFold(strings.TrimSpace(key), name) {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// splitMessageContent splits a message into its outer header fields, which stay outside of a signature or encryption, and
+// its content, its Content-* header fields and body, with line endings canonicalized to CRLF as RFC 8551 requires.
+func splitMessageContent(message []byte) ([]byte, []byte) {
+	header, body := splitEntity(message)
+	var outer, content bytes.Buffer
+	for _, field := range header {
+		key, _, _ := strings.Cut(field, ":")
+		key = strings.TrimSpace(key)
+		switch {
+		case key == "":
+		case strings.EqualFold(key, "MIME-Version"):
+		case strings.HasPrefix(strings.ToLower(key), "content-"):
+			content.WriteString(field + "\r\n")
+		default:
+			outer.WriteString(field + "\r\n")
+		}
+	}
+	if content.Len() == 0 {
+		content.WriteString("Content-Type: text/plain; charset=us-ascii\r\n")
+	}
+	content.WriteString("\r\n")
+	content.Write(canonicalizeLineEndings(body))
+	return outer.Bytes(), content.Bytes()
+}
+
+// splitSigned splits the body of a multipart/signed entity into its signed content, exactly as it appears in the body,
+// and its decoded signature.
+func splitSigned(body []byte, boundary string) ([]byte, []byte, error) {
+	if boundary == "" {
+		return nil, nil, fmt.Errorf("smime: multipart/signed message without a boundary")
+	}
+	body = canonicalizeLineEndings(body)
+	delimiter := []byte("--" + boundary)
+	parts := bytes.Split(body, delimiter)
+	// Parts are preceded by a preamble and followed by the closing delimiter's "--" and an epilogue.
+	if len(parts) < 4 {
+		return nil, nil, fmt.Errorf("smime: multipart/signed message has %d parts, expected 2", len(parts)-2)
+	}
+	content := bytes.TrimSuffix(bytes.TrimPrefix(parts[1], []byte("\r\n")), []byte("\r\n"))
+	signaturePart := bytes.TrimSuffix(bytes.TrimPrefix(parts[2], []byte("\r\n")), []byte("\r\n"))
+	header, signatureBody := splitEntity(signaturePart)
+	signature, err := decodeEntityBody(header, signatureBody)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, signature, nil
+}
+
+// decodeEntityBody decodes a body according to its header's Content-Transfer-Encoding.
+func decodeEntityBody(header []string, body []byte) ([]byte, error) {
+	var r io.Reader = bytes.NewReader(body)
+	if strings.EqualFold(headerValue(header, "Content-Transfer-Encoding"), "base64") {
+		r = base64.NewDecoder(base64.StdEncoding, &base64LineReader{r: r})
+	}
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("smime: %w", err)
+	}
+	return decoded, nil
+}
+
+func canonicalizeLineEndings(content []byte) []byte {
+	return bytes.ReplaceAll(bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n")), []byte("\n"), []byte("\r\n"))
+}
+
+func smimeBoundary() (string, error) {
+	var random [16]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return "", fmt.Errorf("smime: %w", err)
+	}
+	return "smime-" + hex.EncodeToString(random[:]), nil
+}
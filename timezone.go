@@ -0,0 +1,260 @@
+package outlook
+
+import (
+	"fmt"
+	"time"
+)
+
+// windowsZones maps each Windows time zone name to the IANA identifier of its representative location, following CLDR's windowsZones.
+var windowsZones = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"UTC-11":                          "Etc/GMT+11",
+	"Aleutian Standard Time":          "America/Adak",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Marquesas Standard Time":         "Pacific/Marquesas",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"UTC-09":                          "Etc/GMT+9",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"UTC-08":                          "Etc/GMT+8",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Mountain Standard Time":          "America/Denver",
+	"Yukon Standard Time":             "America/Whitehorse",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Easter Island Standard Time":     "Pacific/Easter",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Eastern Standard Time":           "America/New_York",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Cuba Standard Time":              "America/Havana",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"Turks And Caicos Standard Time":  "America/Grand_Turk",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"Tocantins Standard Time":         "America/Araguaina",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"Greenland Standard Time":         "America/Nuuk",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"Magallanes Standard Time":        "America/Punta_Arenas",
+	"Saint Pierre Standard Time":      "America/Miquelon",
+	"Bahia Standard Time":             "America/Bahia",
+	"UTC-02":                          "Etc/GMT+2",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"UTC":                             "Etc/UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Sao Tome Standard Time":          "Africa/Sao_Tome",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"Jordan Standard Time":            "Asia/Amman",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Syria Standard Time":             "Asia/Damascus",
+	"West Bank Standard Time":         "Asia/Hebron",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"FLE Standard Time":               "Europe/Kyiv",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"South Sudan Standard Time":       "Africa/Juba",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Sudan Standard Time":             "Africa/Khartoum",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Namibia Standard Time":           "Africa/Windhoek",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"Qyzylorda Standard Time":         "Asia/Qyzylorda",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"India Standard Time":             "Asia/Kolkata",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Nepal Standard Time":             "Asia/Kathmandu",
+	"Central Asia Standard Time":      "Asia/Bishkek",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Myanmar Standard Time":           "Asia/Yangon",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"China Standard Time":             "Asia/Shanghai",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"Aus Central W. Standard Time":    "Australia/Eucla",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"North Korea Standard Time":       "Asia/Pyongyang",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Lord Howe Standard Time":         "Australia/Lord_Howe",
+	"Bougainville Standard Time":      "Pacific/Bougainville",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Norfolk Standard Time":           "Pacific/Norfolk",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"UTC+12":                          "Etc/GMT-12",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"Chatham Islands Standard Time":   "Pacific/Chatham",
+	"UTC+13":                          "Etc/GMT-13",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+
+	// Retired Windows names older mailboxes and events may still carry.
+	"Mexico Standard Time":       "America/Mexico_City",
+	"Mexico Standard Time 2":     "America/Chihuahua",
+	"Mid-Atlantic Standard Time": "Etc/GMT+2",
+	"Kamchatka Standard Time":    "Asia/Kamchatka",
+	"Armenian Standard Time":     "Asia/Yerevan",
+}
+
+// ianaZones maps IANA identifiers other than the representative ones in windowsZones, including older aliases, to their Windows name.
+var ianaZones = map[string]string{
+	// Zones several Windows names map to, resolved to the current name.
+	"Etc/GMT+2":           "UTC-02",
+	"America/Mexico_City": "Central Standard Time (Mexico)",
+	"Asia/Kamchatka":      "Russia Time Zone 11",
+	"Asia/Yerevan":        "Caucasus Standard Time",
+
+	"UTC":                  "UTC",
+	"Etc/GMT":              "UTC",
+	"GMT":                  "UTC",
+	"America/Toronto":      "Eastern Standard Time",
+	"America/Detroit":      "Eastern Standard Time",
+	"America/Nassau":       "Eastern Standard Time",
+	"America/Vancouver":    "Pacific Standard Time",
+	"America/Edmonton":     "Mountain Standard Time",
+	"America/Boise":        "Mountain Standard Time",
+	"America/Winnipeg":     "Central Standard Time",
+	"America/Lima":         "SA Pacific Standard Time",
+	"America/Panama":       "SA Pacific Standard Time",
+	"America/Indianapolis": "US Eastern Standard Time",
+	"America/Buenos_Aires": "Argentina Standard Time",
+	"America/Godthab":      "Greenland Standard Time",
+	"America/Chihuahua":    "Central Standard Time (Mexico)",
+	"Europe/Dublin":        "GMT Standard Time",
+	"Europe/Lisbon":        "GMT Standard Time",
+	"Europe/Amsterdam":     "W. Europe Standard Time",
+	"Europe/Rome":          "W. Europe Standard Time",
+	"Europe/Vienna":        "W. Europe Standard Time",
+	"Europe/Stockholm":     "W. Europe Standard Time",
+	"Europe/Oslo":          "W. Europe Standard Time",
+	"Europe/Zurich":        "W. Europe Standard Time",
+	"Europe/Luxembourg":    "W. Europe Standard Time",
+	"Europe/Brussels":      "Romance Standard Time",
+	"Europe/Copenhagen":    "Romance Standard Time",
+	"Europe/Madrid":        "Romance Standard Time",
+	"Europe/Prague":        "Central Europe Standard Time",
+	"Europe/Belgrade":      "Central Europe Standard Time",
+	"Europe/Bratislava":    "Central Europe Standard Time",
+	"Europe/Ljubljana":     "Central Europe Standard Time",
+	"Europe/Sarajevo":      "Central European Standard Time",
+	"Europe/Zagreb":        "Central European Standard Time",
+	"Europe/Skopje":        "Central European Standard Time",
+	"Europe/Athens":        "GTB Standard Time",
+	"Europe/Helsinki":      "FLE Standard Time",
+	"Europe/Kiev":          "FLE Standard Time",
+	"Europe/Riga":          "FLE Standard Time",
+	"Europe/Tallinn":       "FLE Standard Time",
+	"Europe/Vilnius":       "FLE Standard Time",
+	"Europe/Sofia":         "FLE Standard Time",
+	"Asia/Kuwait":          "Arab Standard Time",
+	"Asia/Qatar":           "Arab Standard Time",
+	"Asia/Muscat":          "Arabian Standard Time",
+	"Asia/Calcutta":        "India Standard Time",
+	"Asia/Katmandu":        "Nepal Standard Time",
+	"Asia/Rangoon":         "Myanmar Standard Time",
+	"Asia/Almaty":          "Central Asia Standard Time",
+	"Asia/Jakarta":         "SE Asia Standard Time",
+	"Asia/Ho_Chi_Minh":     "SE Asia Standard Time",
+	"Asia/Saigon":          "SE Asia Standard Time",
+	"Asia/Hong_Kong":       "China Standard Time",
+	"Asia/Kuala_Lumpur":    "Singapore Standard Time",
+	"Asia/Manila":          "Singapore Standard Time",
+	"Australia/Melbourne":  "AUS Eastern Standard Time",
+	"Australia/Canberra":   "AUS Eastern Standard Time",
+	"Africa/Algiers":       "W. Central Africa Standard Time",
+	"Africa/Abidjan":       "Greenwich Standard Time",
+	"Africa/Accra":         "Greenwich Standard Time",
+	"Africa/Addis_Ababa":   "E. Africa Standard Time",
+}
+
+func init() {
+	for windows, iana := range windowsZones {
+		if _, ok := ianaZones[iana]; !ok {
+			ianaZones[iana] = windows
+		}
+	}
+}
+
+// WindowsToIANA returns the IANA identifier, e.g. "America/Los_Angeles", of the given Windows time zone name, e.g. "Pacific Standard Time",
+// as used by graph in event payloads and mailboxSettings. ok is false for names it does not know.
+func WindowsToIANA(windows string) (iana string, ok bool) {
+	iana, ok = windowsZones[windows]
+	return iana, ok
+}
+
+// IANAToWindows returns the Windows time zone name of the given IANA identifier. ok is false for identifiers it does not know.
+func IANAToWindows(iana string) (windows string, ok bool) {
+	windows, ok = ianaZones[iana]
+	return windows, ok
+}
+
+// LoadTimeZone returns the location of a time zone given either as a Windows name or as an IANA identifier, as graph may return either.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if iana, ok := windowsZones[name]; ok {
+		name = iana
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("outlook: unknown time zone %q: %w", name, err)
+	}
+	return loc, nil
+}
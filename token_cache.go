@@ -0,0 +1,228 @@
+package outlook
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenCache persists tokens so they survive process restarts. Implementations backed by OS keychains or secret managers
+// can be plugged in anywhere a TokenCache is accepted. Load returns a nil token and nil error when key has no entry.
+type TokenCache interface {
+	Load(ctx context.Context, key string) (*oauth2.Token, error)
+	Store(ctx context.Context, key string, token *oauth2.Token) error
+	Delete(ctx context.Context, key string) error
+}
+
+// SetAuthTokenCache returns an AuthOpt which restores tokens from cache under key instead of prompting the user when possible,
+// and stores every token acquired, including refreshed ones.
+func SetAuthTokenCache(cache TokenCache, key string) AuthOpt {
+	return func(o *authOptions) {
+		o.cache = cache
+		o.cacheKey = key
+	}
+}
+
+// restore returns a TokenSource built from the cached token when one exists which is either still valid or refreshable.
+func (o *authOptions) restore(ctx context.Context, config *oauth2.Config) (oauth2.TokenSource, error) {
+	if o.cache == nil {
+		return nil, nil
+	}
+
+	token, err := o.cache.Load(ctx, o.cacheKey)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || (!token.Valid() && token.RefreshToken == "") {
+		return nil, nil
+	}
+	return o.wrap(ctx, newRefreshTokenSource(ctx, config, token), token), nil
+}
+
+// wrap returns src decorated with the configured token cache and rotation callback, if any.
+func (o *authOptions) wrap(ctx context.Context, src oauth2.TokenSource, initial *oauth2.Token) oauth2.TokenSource {
+	if o.cache != nil {
+		src = &cachingTokenSource{
+			ctx:   ctx,
+			cache: o.cache,
+			key:   o.cacheKey,
+			base:  src,
+			last:  initial,
+		}
+	}
+	if o.rotation != nil {
+		src = NewRotatingTokenSource(src, initial, o.rotation)
+	}
+	return src
+}
+
+// NewCachedTokenSource returns a TokenSource which stores every new token produced by src in cache under key.
+func NewCachedTokenSource(ctx context.Context, cache TokenCache, key string, src oauth2.TokenSource) oauth2.TokenSource {
+	return &cachingTokenSource{
+		ctx:   ctx,
+		cache: cache,
+		key:   key,
+		base:  src,
+	}
+}
+
+type cachingTokenSource struct {
+	ctx   context.Context
+	cache TokenCache
+	key   string
+	base  oauth2.TokenSource
+
+	mu   sync.Mutex
+	last *oauth2.Token
+}
+
+func (cts *cachingTokenSource) Token() (*oauth2.Token, error) {
+	return cts.TokenContext(cts.ctx)
+}
+
+func (cts *cachingTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	token, err := TokenWithContext(ctx, cts.base)
+	if err != nil {
+		return nil, err
+	}
+
+	cts.mu.Lock()
+	defer cts.mu.Unlock()
+	if cts.last == nil || cts.last.AccessToken != token.AccessToken || cts.last.RefreshToken != token.RefreshToken {
+		if err := cts.cache.Store(ctx, cts.key, token); err != nil {
+			return nil, fmt.Errorf("failed to store token in cache: %w", err)
+		}
+		cts.last = token
+	}
+	return token, nil
+}
+
+// EncryptedFileTokenCache a TokenCache which keeps every entry in a single file encrypted with AES-256-GCM.
+type EncryptedFileTokenCache struct {
+	path string
+	aead cipher.AEAD
+	mu   sync.Mutex
+}
+
+// NewEncryptedFileTokenCache returns a new instance of an EncryptedFileTokenCache storing tokens at path, encrypted with the given 32 byte key.
+// The key should itself be kept somewhere safer than the file, e.g. an OS keychain or secret manager.
+func NewEncryptedFileTokenCache(path string, key []byte) (*EncryptedFileTokenCache, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("token cache key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedFileTokenCache{path: path, aead: aead}, nil
+}
+
+// Load returns the token stored under key, or nil if there is none.
+func (c *EncryptedFileTokenCache) Load(ctx context.Context, key string) (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := c.read()
+	if err != nil {
+		return nil, err
+	}
+	return entries[key], nil
+}
+
+// Store saves token under key, replacing any existing entry.
+func (c *EncryptedFileTokenCache) Store(ctx context.Context, key string, token *oauth2.Token) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := c.read()
+	if err != nil {
+		return err
+	}
+	entries[key] = token
+	return c.write(entries)
+}
+
+// Delete removes the entry stored under key.
+func (c *EncryptedFileTokenCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := c.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := entries[key]; !ok {
+		return nil
+	}
+	delete(entries, key)
+	return c.write(entries)
+}
+
+func (c *EncryptedFileTokenCache) read() (map[string]*oauth2.Token, error) {
+	entries := map[string]*oauth2.Token{}
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("token cache %s is corrupt", c.path)
+	}
+	plaintext, err := c.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token cache %s: %w", c.path, err)
+	}
+	if err := json.Unmarshal(plaintext, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse token cache %s: %w", c.path, err)
+	}
+	return entries, nil
+}
+
+func (c *EncryptedFileTokenCache) write(entries map[string]*oauth2.Token) error {
+	plaintext, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	data := c.aead.Seal(nonce, nonce, plaintext, nil)
+
+	// Write to a temporary file first so a crash never leaves a truncated cache behind.
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path)
+}
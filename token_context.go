@@ -0,0 +1,142 @@
+package outlook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// ContextTokenSource a TokenSource which can acquire tokens under a caller's context, so a request's cancellation and deadline
+// also bound the token refresh it may need. Every TokenSource this package returns implements it.
+type ContextTokenSource interface {
+	oauth2.TokenSource
+	TokenContext(ctx context.Context) (*oauth2.Token, error)
+}
+
+// TokenWithContext returns a token from src under ctx. Sources which are not a ContextTokenSource are called in the background,
+// and abandoned, rather than cancelled, when ctx is done first.
+func TokenWithContext(ctx context.Context, src oauth2.TokenSource) (*oauth2.Token, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if cts, ok := src.(ContextTokenSource); ok {
+		return cts.TokenContext(ctx)
+	}
+
+	type result struct {
+		token *oauth2.Token
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		token, err := src.Token()
+		done <- result{token, err}
+	}()
+	select {
+	case r := <-done:
+		return r.token, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// NewReuseTokenSource returns a TokenSource which, like oauth2.ReuseTokenSource, keeps returning token, and then the tokens
+// src produces, until they expire. Unlike it, the returned source is a ContextTokenSource, passing the caller's context on to src
+// when refreshing, and callers waiting on another's refresh give up when their context is done.
+func NewReuseTokenSource(token *oauth2.Token, src oauth2.TokenSource) oauth2.TokenSource {
+	if rts, ok := src.(*reuseTokenSource); ok && token == nil {
+		return rts
+	}
+	return &reuseTokenSource{
+		base:  src,
+		token: token,
+		sem:   make(chan struct{}, 1),
+	}
+}
+
+type reuseTokenSource struct {
+	base oauth2.TokenSource
+	// sem a mutex which waiters can give up on, held while reading or refreshing token.
+	sem   chan struct{}
+	token *oauth2.Token
+}
+
+func (rts *reuseTokenSource) Token() (*oauth2.Token, error) {
+	return rts.TokenContext(context.Background())
+}
+
+func (rts *reuseTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	select {
+	case rts.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-rts.sem }()
+
+	if rts.token.Valid() {
+		return rts.token, nil
+	}
+	token, err := TokenWithContext(ctx, rts.base)
+	if err != nil {
+		return nil, err
+	}
+	rts.token = token
+	return token, nil
+}
+
+// newRefreshTokenSource returns a TokenSource which refreshes token through config's token endpoint once it expires, as
+// config.TokenSource does, but under the caller's context when used as a ContextTokenSource.
+func newRefreshTokenSource(ctx context.Context, config *oauth2.Config, token *oauth2.Token) oauth2.TokenSource {
+	return NewReuseTokenSource(token, &refreshTokenSource{
+		ctx:          ctx,
+		config:       config,
+		refreshToken: token.RefreshToken,
+	})
+}
+
+type refreshTokenSource struct {
+	ctx    context.Context
+	config *oauth2.Config
+
+	mu           sync.Mutex
+	refreshToken string
+}
+
+func (rts *refreshTokenSource) Token() (*oauth2.Token, error) {
+	return rts.TokenContext(rts.ctx)
+}
+
+func (rts *refreshTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	rts.mu.Lock()
+	refreshToken := rts.refreshToken
+	rts.mu.Unlock()
+	if refreshToken == "" {
+		return nil, errors.New("oauth2: token expired and refresh token is not set")
+	}
+
+	// An expired token makes config's source refresh right away, under the context it was given.
+	token, err := rts.config.TokenSource(withTokenHTTPClient(ctx, rts.ctx), &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+
+	rts.mu.Lock()
+	rts.refreshToken = token.RefreshToken
+	rts.mu.Unlock()
+	return token, nil
+}
+
+// withTokenHTTPClient returns ctx carrying the http client registered on base under oauth2.HTTPClient, if ctx has none of its
+// own, so tokens acquired under a request's context still go through the client the token source was built with.
+func withTokenHTTPClient(ctx, base context.Context) context.Context {
+	if client, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && client != nil {
+		return ctx
+	}
+	if client, ok := base.Value(oauth2.HTTPClient).(*http.Client); ok && client != nil {
+		return context.WithValue(ctx, oauth2.HTTPClient, client)
+	}
+	return ctx
+}
@@ -0,0 +1,62 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenRotationFunc is invoked with the full token set whenever the token endpoint issues a new refresh token, so it can be persisted
+// in place of the previous one. Microsoft rotates refresh tokens on use, and stored tokens which are never updated eventually stop working.
+type TokenRotationFunc func(token *oauth2.Token) error
+
+// SetAuthTokenRotation returns an AuthOpt which invokes fn whenever a new refresh token is issued.
+func SetAuthTokenRotation(fn TokenRotationFunc) AuthOpt {
+	return func(o *authOptions) {
+		o.rotation = fn
+	}
+}
+
+// NewRotatingTokenSource returns a TokenSource which invokes fn whenever src produces a refresh token different from the last one seen.
+// current is the token src was built from, typically one loaded from storage, and may be nil.
+func NewRotatingTokenSource(src oauth2.TokenSource, current *oauth2.Token, fn TokenRotationFunc) oauth2.TokenSource {
+	rts := &rotatingTokenSource{
+		base:     src,
+		rotation: fn,
+	}
+	if current != nil {
+		rts.refreshToken = current.RefreshToken
+	}
+	return rts
+}
+
+type rotatingTokenSource struct {
+	base     oauth2.TokenSource
+	rotation TokenRotationFunc
+
+	mu           sync.Mutex
+	refreshToken string
+}
+
+func (rts *rotatingTokenSource) Token() (*oauth2.Token, error) {
+	return rts.TokenContext(context.Background())
+}
+
+func (rts *rotatingTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	token, err := TokenWithContext(ctx, rts.base)
+	if err != nil {
+		return nil, err
+	}
+
+	rts.mu.Lock()
+	defer rts.mu.Unlock()
+	if token.RefreshToken != "" && token.RefreshToken != rts.refreshToken {
+		if err := rts.rotation(token); err != nil {
+			return nil, fmt.Errorf("failed to persist rotated refresh token: %w", err)
+		}
+		rts.refreshToken = token.RefreshToken
+	}
+	return token, nil
+}
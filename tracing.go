@@ -0,0 +1,105 @@
+package outlook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/ntauth/go-outlook"
+
+// SetClientTracerProvider returns a ClientOpt function which sets the TracerProvider spans for graph calls are created with.
+// Defaults to the global provider registered with otel.SetTracerProvider, which records nothing until one is registered.
+func SetClientTracerProvider(provider trace.TracerProvider) ClientOpt {
+	return func(c *Client) {
+		c.tracerProvider = provider
+	}
+}
+
+func (client *Client) tracer() trace.Tracer {
+	provider := client.tracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName, trace.WithInstrumentationVersion(ClientVersion))
+}
+
+// startSpan starts a client span for req. The span is named after the request's path template so that calls for
+// different mailboxes and items aggregate together, and to keep ids and addresses out of trace backends.
+func (client *Client) startSpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	template := pathTemplate(req.URL.Path)
+	return client.tracer().Start(ctx, req.Method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.template", template),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("graph.client_request_id", req.Header.Get("client-request-id")),
+		),
+	)
+}
+
+// queueWaitKey the context key under which Session passes the time a call waited for its mailbox's concurrency limit to Client.Do.
+type queueWaitKey struct{}
+
+// endSpan records the outcome of a graph call on span and ends it, along with how often it was retried and how long it
+// waited on the client's limiters and between retries.
+func endSpan(span trace.Span, res *http.Response, err error, stats *requestStats) {
+	defer span.End()
+
+	retries := 0
+	if stats.attempts > 1 {
+		retries = stats.attempts - 1
+	}
+	span.SetAttributes(
+		attribute.Int("graph.retries", retries),
+		attribute.Int64("graph.wait_ms", stats.waited.Milliseconds()),
+	)
+
+	if res != nil {
+		span.SetAttributes(
+			attribute.Int("http.response.status_code", res.StatusCode),
+			attribute.String("graph.request_id", res.Header.Get("request-id")),
+		)
+	}
+
+	var graphErr *GraphError
+	if errors.As(err, &graphErr) && graphErr.Throttle != nil {
+		span.SetAttributes(
+			attribute.Int64("graph.throttle.retry_after_ms", graphErr.SuggestedRetryDuration.Milliseconds()),
+			attribute.String("graph.throttle.scope", graphErr.Throttle.Scope),
+		)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+var (
+	// functionCallPattern matches the arguments of an OData function segment, e.g. reminderView(startDateTime='...').
+	functionCallPattern = regexp.MustCompile(`\(.*\)$`)
+	// idSegmentPattern matches the segments graph uses as identifiers: base64 item ids, guids, numbers, and addresses.
+	idSegmentPattern = regexp.MustCompile(`^([A-Za-z0-9_=+\-]{40,}|[0-9a-fA-F\-]{32,36}|[0-9]+)$`)
+)
+
+// pathTemplate returns urlPath with identifiers replaced by {id}, e.g. /v1.0/users/{id}/messages/{id}/attachments.
+func pathTemplate(urlPath string) string {
+	segments := strings.Split(urlPath, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.Contains(segment, "("):
+			segments[i] = functionCallPattern.ReplaceAllString(segment, "(...)")
+		case strings.Contains(segment, "@") || idSegmentPattern.MatchString(segment):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
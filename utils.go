@@ -1,11 +1,11 @@
 package outlook
 
 import (
+	"crypto/rand"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -15,19 +15,26 @@ func checkResponse(res *http.Response) error {
 		return nil
 	}
 
-	statusErr := &ErrStatusCode{Code: status}
+	statusErr := &GraphError{
+		StatusCode:      status,
+		RequestID:       res.Header.Get("request-id"),
+		ClientRequestID: res.Header.Get("client-request-id"),
+		Date:            res.Header.Get("Date"),
+	}
+	if statusErr.ClientRequestID == "" && res.Request != nil {
+		statusErr.ClientRequestID = res.Request.Header.Get("client-request-id")
+	}
 	data, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return err
 	}
 	if len(data) > 0 {
-		statusErr.Message = string(data)
+		statusErr.parseBody(data)
 	}
-	if statusErr.Code == 429 {
-		rawRetrySecs := res.Header.Get("Retry-After")
-		if rawRetrySecs != "" {
-			retrySecs, _ := strconv.ParseInt(rawRetrySecs, 10, 64)
-			statusErr.SuggestedRetryDuration = time.Duration(retrySecs) * time.Second
+	if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+		statusErr.Throttle = parseThrottleInfo(res.Header, time.Now())
+		if statusErr.Throttle != nil {
+			statusErr.SuggestedRetryDuration = statusErr.Throttle.RetryAfter
 		}
 	}
 
@@ -39,11 +46,7 @@ func createQueryString(params map[string]interface{}) string {
 	for key, val := range params {
 		query.Set(key, fmt.Sprintf("%v", val))
 	}
-	finalQuery := query.Encode()
-	if finalQuery == "" {
-		return ""
-	}
-	return fmt.Sprintf("?%s", finalQuery)
+	return query.Encode()
 }
 
 func parsePageLink(link, key string) string {
@@ -51,3 +54,38 @@ func parsePageLink(link, key string) string {
 	q := parsed.Query()
 	return q.Get(key)
 }
+
+func utcDateTimeTimeZone(t time.Time) *DateTimeTimeZone {
+	return NewDateTimeTimeZone(t.UTC())
+}
+
+// newUUID returns a random (version 4) uuid.
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand only fails if the OS entropy source is unavailable, in which case correlation ids are the least of our problems.
+		return ""
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// appendMissing appends the values which list does not contain yet.
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		if !containsString(list, value) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
@@ -0,0 +1,113 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Well-known folder names, which graph accepts anywhere a folder id is expected, e.g. Messages().List(FolderInbox).
+const (
+	FolderArchive                   = "archive"
+	FolderClutter                   = "clutter"
+	FolderConflicts                 = "conflicts"
+	FolderConversationHistory       = "conversationhistory"
+	FolderDeletedItems              = "deleteditems"
+	FolderDrafts                    = "drafts"
+	FolderInbox                     = "inbox"
+	FolderJunkEmail                 = "junkemail"
+	FolderLocalFailures             = "localfailures"
+	FolderMsgFolderRoot             = "msgfolderroot"
+	FolderOutbox                    = "outbox"
+	FolderRecoverableItemsDeletions = "recoverableitemsdeletions"
+	FolderScheduled                 = "scheduled"
+	FolderSearchFolders             = "searchfolders"
+	FolderSentItems                 = "sentitems"
+	FolderServerFailures            = "serverfailures"
+	FolderSyncIssues                = "syncissues"
+)
+
+var wellKnownFolders = map[string]bool{
+	FolderArchive:                   true,
+	FolderClutter:                   true,
+	FolderConflicts:                 true,
+	FolderConversationHistory:       true,
+	FolderDeletedItems:              true,
+	FolderDrafts:                    true,
+	FolderInbox:                     true,
+	FolderJunkEmail:                 true,
+	FolderLocalFailures:             true,
+	FolderMsgFolderRoot:             true,
+	FolderOutbox:                    true,
+	FolderRecoverableItemsDeletions: true,
+	FolderScheduled:                 true,
+	FolderSearchFolders:             true,
+	FolderSentItems:                 true,
+	FolderServerFailures:            true,
+	FolderSyncIssues:                true,
+}
+
+// IsWellKnownFolder reports whether name is one of the well-known folder names, ignoring case as graph does.
+func IsWellKnownFolder(name string) bool {
+	return wellKnownFolders[strings.ToLower(name)]
+}
+
+// folderIDCache the ids well-known folder names resolved to, per mailbox. Folder ids never change, so entries never expire.
+type folderIDCache struct {
+	mu  sync.Mutex
+	ids map[string]string
+}
+
+func (c *folderIDCache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.ids[key]
+	return id, ok
+}
+
+func (c *folderIDCache) set(key, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ids == nil {
+		c.ids = map[string]string{}
+	}
+	c.ids[key] = id
+}
+
+// FolderResolveCall struct allowing for fluent style configuration of calls resolving a folder's actual id.
+type FolderResolveCall struct {
+	service  *FolderService
+	folderID string
+}
+
+// Resolve returns an instance of a FolderResolveCall for the given folderID, which may be a well-known folder name.
+// Use it where a real id is needed, e.g. to compare against a message's parentFolderId.
+func (fs *FolderService) Resolve(folderID string) *FolderResolveCall {
+	return &FolderResolveCall{
+		service:  fs,
+		folderID: folderID,
+	}
+}
+
+// Do executes the call, returning the folder's id. Ids which are not well-known folder names are returned as is, and
+// resolved names are cached on the client per mailbox, so only the first call for a name requests graph.
+func (frc *FolderResolveCall) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	if !IsWellKnownFolder(frc.folderID) {
+		return frc.folderID, nil
+	}
+
+	session := frc.service.session
+	key := fmt.Sprintf("%s|%s", session.mailboxKey(), strings.ToLower(frc.folderID))
+	if id, ok := session.client.folderIDs.get(key); ok {
+		return id, nil
+	}
+
+	path := fmt.Sprintf("%s/%s", frc.service.basePath, frc.folderID)
+	var folder Folder
+	if _, err := session.Get(ctx, path, map[string]interface{}{"$select": "id"}, &folder, opts...); err != nil {
+		return "", err
+	}
+	session.client.folderIDs.set(key, folder.ID)
+	return folder.ID, nil
+}